@@ -1,8 +1,9 @@
 module github.com/amalucelli/nextdns-go
 
-go 1.19
+go 1.23
 
 require (
 	github.com/hashicorp/go-cleanhttp v0.5.2
 	github.com/matryer/is v1.4.0
+	golang.org/x/time v0.9.0
 )
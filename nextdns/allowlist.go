@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -19,6 +20,9 @@ type Allowlist struct {
 type CreateAllowlistRequest struct {
 	ProfileID string
 	Allowlist []*Allowlist
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // ListAllowlistRequest encapsulates the request for getting an allowlist.
@@ -33,11 +37,54 @@ type UpdateAllowlistRequest struct {
 	Allowlist *Allowlist
 }
 
+// AddAllowlistRequest encapsulates the request for adding a domain to a allowlist.
+type AddAllowlistRequest struct {
+	ProfileID string
+	Allowlist *Allowlist
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeleteAllowlistRequest encapsulates the request for deleting a domain from a allowlist.
+type DeleteAllowlistRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
+// AddAllowlistEntriesRequest encapsulates the request for adding domains to the allowlist, keeping the other domains.
+type AddAllowlistEntriesRequest struct {
+	ProfileID string
+	Allowlist []*Allowlist
+}
+
+// RemoveAllowlistEntriesRequest encapsulates the request for removing domains from the allowlist, keeping the other domains.
+type RemoveAllowlistEntriesRequest struct {
+	ProfileID string
+	IDs       []string
+}
+
+// SetAllowlistActiveRequest encapsulates the request for activating or deactivating domains of the allowlist.
+type SetAllowlistActiveRequest struct {
+	ProfileID string
+	IDs       []string
+	Active    bool
+}
+
 // AllowlistService is an interface for communicating with the NextDNS allowlist API endpoint.
 type AllowlistService interface {
 	Create(context.Context, *CreateAllowlistRequest) error
 	List(context.Context, *ListAllowlistRequest) ([]*Allowlist, error)
+	All(context.Context, *ListAllowlistRequest) iter.Seq2[*Allowlist, error]
 	Update(context.Context, *UpdateAllowlistRequest) error
+	Add(context.Context, *AddAllowlistRequest) error
+	Delete(context.Context, *DeleteAllowlistRequest) error
+	AddEntries(context.Context, *AddAllowlistEntriesRequest) error
+	RemoveEntries(context.Context, *RemoveAllowlistEntriesRequest) error
+	SetActive(context.Context, *SetAllowlistActiveRequest) error
 }
 
 // allowlistResponse represents the allowlist response.
@@ -61,14 +108,16 @@ func NewAllowlistService(client *Client) *allowlistService {
 }
 
 // Create creates an allowlist for a profile.
+// It replaces the whole allowlist, use AddEntries to add domains while keeping the others.
 func (s *allowlistService) Create(ctx context.Context, request *CreateAllowlistRequest) error {
+	ctx = withOperation(ctx, "Allowlist", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), allowlistAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.Allowlist)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.Allowlist)
 	if err != nil {
 		return fmt.Errorf("error creating request to create an allow list: %w", err)
 	}
 
-	err = s.client.do(ctx, req, nil)
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create an allow list: %w", err)
 	}
@@ -78,8 +127,9 @@ func (s *allowlistService) Create(ctx context.Context, request *CreateAllowlistR
 
 // List returns the allowlist of a profile.
 func (s *allowlistService) List(ctx context.Context, request *ListAllowlistRequest) ([]*Allowlist, error) {
+	ctx = withOperation(ctx, "Allowlist", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), allowlistAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the allow list: %w", err)
 	}
@@ -93,10 +143,18 @@ func (s *allowlistService) List(ctx context.Context, request *ListAllowlistReque
 	return response.Allowlist, nil
 }
 
+// All returns an iterator over the allowlist of a profile.
+func (s *allowlistService) All(ctx context.Context, request *ListAllowlistRequest) iter.Seq2[*Allowlist, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*Allowlist, error) {
+		return s.List(ctx, request)
+	})
+}
+
 // Update updates an allowlist of a profile.
 func (s *allowlistService) Update(ctx context.Context, request *UpdateAllowlistRequest) error {
+	ctx = withOperation(ctx, "Allowlist", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), allowlistIDAPIPath(request.ID))
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Allowlist)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Allowlist)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the allow list id %s: %w", request.ID, err)
 	}
@@ -109,7 +167,120 @@ func (s *allowlistService) Update(ctx context.Context, request *UpdateAllowlistR
 	return nil
 }
 
+// Add adds a domain to the allowlist of a profile.
+func (s *allowlistService) Add(ctx context.Context, request *AddAllowlistRequest) error {
+	ctx = withOperation(ctx, "Allowlist", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), allowlistAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.Allowlist)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the allow list: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the allow list: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a domain from the allowlist of a profile.
+func (s *allowlistService) Delete(ctx context.Context, request *DeleteAllowlistRequest) error {
+	ctx = withOperation(ctx, "Allowlist", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), allowlistIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the allow list id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the allow list id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// AddEntries adds domains to the allowlist of a profile. Unlike Create, which replaces the whole list, only the
+// missing domains and the ones whose settings differ are sent, so the other domains are left as they are.
+func (s *allowlistService) AddEntries(ctx context.Context, request *AddAllowlistEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "Allowlist", "AddEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).addEntries(ctx, request.Allowlist)
+	if err != nil {
+		return fmt.Errorf("error adding the entries of the allow list: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntries removes domains from the allowlist of a profile, only deleting the ones that are in the list.
+func (s *allowlistService) RemoveEntries(ctx context.Context, request *RemoveAllowlistEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "Allowlist", "RemoveEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).removeEntries(ctx, request.IDs)
+	if err != nil {
+		return fmt.Errorf("error removing the entries of the allow list: %w", err)
+	}
+
+	return nil
+}
+
+// SetActive activates or deactivates domains of the allowlist of a profile, only updating the ones that differ.
+// It returns ErrNotFound, without updating any of them, when some domains are not in the list.
+func (s *allowlistService) SetActive(ctx context.Context, request *SetAllowlistActiveRequest) (err error) {
+	ctx = withOperation(ctx, "Allowlist", "SetActive", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).setActiveEntries(ctx, request.IDs, request.Active)
+	if err != nil {
+		return fmt.Errorf("error setting the active entries of the allow list: %w", err)
+	}
+
+	return nil
+}
+
+// merge returns the per-item operations used to merge changes into the allowlist of a profile.
+func (s *allowlistService) merge(profileID string) listMerge[Allowlist] {
+	return listMerge[Allowlist]{
+		list: func(ctx context.Context) ([]*Allowlist, error) {
+			return s.List(ctx, &ListAllowlistRequest{ProfileID: profileID})
+		},
+		add: func(ctx context.Context, item *Allowlist) error {
+			return s.Add(ctx, &AddAllowlistRequest{ProfileID: profileID, Allowlist: item, IgnoreDuplicates: true})
+		},
+		update: func(ctx context.Context, item *Allowlist) error {
+			return s.Update(ctx, &UpdateAllowlistRequest{ProfileID: profileID, ID: item.ID, Allowlist: item})
+		},
+		remove: func(ctx context.Context, id string) error {
+			return s.Delete(ctx, &DeleteAllowlistRequest{ProfileID: profileID, ID: id, IgnoreNotFound: true})
+		},
+		id: func(item *Allowlist) string {
+			return item.ID
+		},
+		equal: func(a *Allowlist, b *Allowlist) bool {
+			return *a == *b
+		},
+		setActive: func(item *Allowlist, active bool) *Allowlist {
+			updated := *item
+			updated.Active = active
+			return &updated
+		},
+	}
+}
+
 // allowlistIDAPIPath returns the HTTP path for the allowlist API.
 func allowlistIDAPIPath(id string) string {
-	return fmt.Sprintf("%s/%s", allowlistAPIPath, id)
+	return itemAPIPath(allowlistAPIPath, id)
 }
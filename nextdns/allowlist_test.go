@@ -97,3 +97,20 @@ func TestAllowlistUpdate(t *testing.T) {
 
 	c.NoErr(err)
 }
+
+func TestAllowlistDelete(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Method, http.MethodDelete)
+		c.Equal(r.URL.Path, "/profiles/abc123/allowlist/duckduckgo.com")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Allowlist.Delete(context.Background(), &DeleteAllowlistRequest{ProfileID: "abc123", ID: "duckduckgo.com"})
+	c.NoErr(err)
+}
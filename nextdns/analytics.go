@@ -0,0 +1,173 @@
+package nextdns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// analyticsStatusAPIPath is the HTTP path for the analytics status API.
+	analyticsStatusAPIPath = "analytics/status"
+
+	// analyticsDomainsAPIPath is the HTTP path for the analytics domains API.
+	analyticsDomainsAPIPath = "analytics/domains"
+
+	// analyticsReasonsAPIPath is the HTTP path for the analytics reasons API.
+	analyticsReasonsAPIPath = "analytics/reasons"
+)
+
+// AnalyticsFilter encapsulates the filters shared by the analytics requests.
+type AnalyticsFilter struct {
+	// TimeRange restricts the analytics to a time range, the API defaults are used when it is unset.
+	TimeRange
+
+	// Device restricts the analytics to a device ID.
+	Device string `url:"device,omitempty"`
+
+	// Limit is the maximum number of results, or zero to use the API default.
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor is the cursor of the page to get, as returned by the previous page.
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// AnalyticsStatus represents the number of queries for a resolution status.
+type AnalyticsStatus struct {
+	Status  string `json:"status"`
+	Queries int    `json:"queries"`
+}
+
+// AnalyticsDomain represents the number of queries for a domain.
+type AnalyticsDomain struct {
+	Domain  string `json:"domain"`
+	Root    string `json:"root,omitempty"`
+	Tracker string `json:"tracker,omitempty"`
+	Queries int    `json:"queries"`
+}
+
+// AnalyticsReason represents the number of queries blocked for a reason (e.g. a blocklist).
+type AnalyticsReason struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Queries int    `json:"queries"`
+}
+
+// GetAnalyticsStatusRequest encapsulates the request for getting the queries by resolution status.
+type GetAnalyticsStatusRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsDomainsRequest encapsulates the request for getting the queries by domain.
+type GetAnalyticsDomainsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+
+	// Status restricts the domains to a resolution status ("default", "blocked" or "allowed").
+	Status string `url:"status,omitempty"`
+
+	// Root groups the domains by their root domain.
+	Root bool `url:"root,omitempty"`
+}
+
+// GetAnalyticsReasonsRequest encapsulates the request for getting the blocked queries by reason.
+type GetAnalyticsReasonsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// AnalyticsService is an interface for communicating with the NextDNS analytics API endpoint.
+type AnalyticsService interface {
+	GetStatus(context.Context, *GetAnalyticsStatusRequest) (*AnalyticsPage[*AnalyticsStatus], error)
+	GetDomains(context.Context, *GetAnalyticsDomainsRequest) (*AnalyticsPage[*AnalyticsDomain], error)
+	GetReasons(context.Context, *GetAnalyticsReasonsRequest) (*AnalyticsPage[*AnalyticsReason], error)
+	GetIPs(context.Context, *GetAnalyticsIPsRequest) (*AnalyticsPage[*AnalyticsIP], error)
+	GetDevices(context.Context, *GetAnalyticsDevicesRequest) (*AnalyticsPage[*AnalyticsDevice], error)
+	GetProtocols(context.Context, *GetAnalyticsProtocolsRequest) (*AnalyticsPage[*AnalyticsProtocol], error)
+	GetQueryTypes(context.Context, *GetAnalyticsQueryTypesRequest) (*AnalyticsPage[*AnalyticsQueryType], error)
+	GetIPVersions(context.Context, *GetAnalyticsIPVersionsRequest) (*AnalyticsPage[*AnalyticsIPVersion], error)
+	GetDNSSEC(context.Context, *GetAnalyticsDNSSECRequest) (*AnalyticsPage[*AnalyticsDNSSEC], error)
+	GetEncryption(context.Context, *GetAnalyticsEncryptionRequest) (*AnalyticsPage[*AnalyticsEncryption], error)
+	GetDestinationCountries(context.Context, *GetAnalyticsDestinationsRequest) (*AnalyticsPage[*AnalyticsDestinationCountry], error)
+	GetDestinationCompanies(context.Context, *GetAnalyticsDestinationsRequest) (*AnalyticsPage[*AnalyticsDestinationCompany], error)
+	GetSeries(context.Context, *GetAnalyticsSeriesRequest) (*TimeSeries, error)
+}
+
+// AnalyticsPage represents a page of the results of an analytics endpoint.
+type AnalyticsPage[T any] struct {
+	Data []T
+
+	// Cursor is the cursor of the next page, or empty if there is no next page.
+	Cursor string
+}
+
+// analyticsResponse represents the response of an analytics endpoint.
+type analyticsResponse[T any] struct {
+	Data []T `json:"data"`
+	Meta struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// analyticsService represents the NextDNS analytics service.
+type analyticsService struct {
+	client *Client
+}
+
+var _ AnalyticsService = &analyticsService{}
+
+// NewAnalyticsService returns a new NextDNS analytics service.
+// nolint: revive
+func NewAnalyticsService(client *Client) *analyticsService {
+	return &analyticsService{
+		client: client,
+	}
+}
+
+// GetStatus returns the number of queries by resolution status.
+func (s *analyticsService) GetStatus(ctx context.Context, request *GetAnalyticsStatusRequest) (*AnalyticsPage[*AnalyticsStatus], error) {
+	ctx = withOperation(ctx, "Analytics", "GetStatus", request.ProfileID)
+	return getAnalytics[*AnalyticsStatus](ctx, s.client, request.ProfileID, analyticsStatusAPIPath, request, "status")
+}
+
+// GetDomains returns the number of queries by domain.
+func (s *analyticsService) GetDomains(ctx context.Context, request *GetAnalyticsDomainsRequest) (*AnalyticsPage[*AnalyticsDomain], error) {
+	ctx = withOperation(ctx, "Analytics", "GetDomains", request.ProfileID)
+	return getAnalytics[*AnalyticsDomain](ctx, s.client, request.ProfileID, analyticsDomainsAPIPath, request, "domains")
+}
+
+// GetReasons returns the number of blocked queries by reason.
+func (s *analyticsService) GetReasons(ctx context.Context, request *GetAnalyticsReasonsRequest) (*AnalyticsPage[*AnalyticsReason], error) {
+	ctx = withOperation(ctx, "Analytics", "GetReasons", request.ProfileID)
+	return getAnalytics[*AnalyticsReason](ctx, s.client, request.ProfileID, analyticsReasonsAPIPath, request, "reasons")
+}
+
+// analyticsQuery represents the query parameters of an analytics request, validated before sending the request.
+type analyticsQuery interface {
+	Validate() error
+}
+
+// getAnalytics returns a page of the data of an analytics endpoint of the profile, name is used in the error messages.
+func getAnalytics[T any](ctx context.Context, client *Client, profileID string, endpoint string, query analyticsQuery, name string) (*AnalyticsPage[T], error) {
+	if err := query.Validate(); err != nil {
+		return nil, fmt.Errorf("error creating request to get the analytics %s: %w", name, err)
+	}
+
+	path := fmt.Sprintf("%s/%s", profileAPIPath(profileID), endpoint)
+	req, err := client.newRequest(http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to get the analytics %s: %w", name, err)
+	}
+
+	response := analyticsResponse[T]{}
+	err = client.do(ctx, req, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error making a request to get the analytics %s: %w", name, err)
+	}
+
+	return &AnalyticsPage[T]{
+		Data:   response.Data,
+		Cursor: response.Meta.Pagination.Cursor,
+	}, nil
+}
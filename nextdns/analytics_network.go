@@ -0,0 +1,218 @@
+package nextdns
+
+import "context"
+
+const (
+	// analyticsIPsAPIPath is the HTTP path for the analytics IPs API.
+	analyticsIPsAPIPath = "analytics/ips"
+
+	// analyticsDevicesAPIPath is the HTTP path for the analytics devices API.
+	analyticsDevicesAPIPath = "analytics/devices"
+
+	// analyticsProtocolsAPIPath is the HTTP path for the analytics protocols API.
+	analyticsProtocolsAPIPath = "analytics/protocols"
+
+	// analyticsQueryTypesAPIPath is the HTTP path for the analytics query types API.
+	analyticsQueryTypesAPIPath = "analytics/queryTypes"
+
+	// analyticsIPVersionsAPIPath is the HTTP path for the analytics IP versions API.
+	analyticsIPVersionsAPIPath = "analytics/ipVersions"
+
+	// analyticsDNSSECAPIPath is the HTTP path for the analytics DNSSEC API.
+	analyticsDNSSECAPIPath = "analytics/dnssec"
+
+	// analyticsEncryptionAPIPath is the HTTP path for the analytics encryption API.
+	analyticsEncryptionAPIPath = "analytics/encryption"
+
+	// analyticsDestinationsAPIPath is the HTTP path for the analytics destinations API.
+	analyticsDestinationsAPIPath = "analytics/destinations"
+)
+
+// AnalyticsIP represents the number of queries from a client IP.
+type AnalyticsIP struct {
+	IP      string            `json:"ip"`
+	Network *AnalyticsNetwork `json:"network,omitempty"`
+	Geo     *AnalyticsGeo     `json:"geo,omitempty"`
+	Queries int               `json:"queries"`
+}
+
+// AnalyticsNetwork represents the network of a client IP.
+type AnalyticsNetwork struct {
+	Cellular bool   `json:"cellular"`
+	VPN      bool   `json:"vpn"`
+	ISP      string `json:"isp"`
+	ASN      int    `json:"asn"`
+}
+
+// AnalyticsGeo represents the geolocation of a client IP.
+type AnalyticsGeo struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	CountryCode string  `json:"countryCode"`
+	Country     string  `json:"country"`
+	City        string  `json:"city"`
+}
+
+// AnalyticsDevice represents the number of queries from a device.
+// Queries from unidentified devices are reported with the "__UNIDENTIFIED__" ID.
+type AnalyticsDevice struct {
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Model   string `json:"model,omitempty"`
+	LocalIP string `json:"localIp,omitempty"`
+	Queries int    `json:"queries"`
+}
+
+// AnalyticsProtocol represents the number of queries made over a protocol (e.g. "DNS-over-HTTPS").
+type AnalyticsProtocol struct {
+	Protocol string `json:"protocol"`
+	Queries  int    `json:"queries"`
+}
+
+// AnalyticsQueryType represents the number of queries of a DNS record type.
+type AnalyticsQueryType struct {
+	Type    int    `json:"type"`
+	Name    string `json:"name"`
+	Queries int    `json:"queries"`
+}
+
+// AnalyticsIPVersion represents the number of queries made over an IP version (4 or 6).
+type AnalyticsIPVersion struct {
+	Version int `json:"version"`
+	Queries int `json:"queries"`
+}
+
+// AnalyticsDNSSEC represents the number of queries validated, or not, with DNSSEC.
+type AnalyticsDNSSEC struct {
+	Validated bool `json:"validated"`
+	Queries   int  `json:"queries"`
+}
+
+// AnalyticsEncryption represents the number of queries made encrypted, or not.
+type AnalyticsEncryption struct {
+	Encrypted bool `json:"encrypted"`
+	Queries   int  `json:"queries"`
+}
+
+// AnalyticsDestinationCountry represents the number of queries resolved to a country.
+type AnalyticsDestinationCountry struct {
+	Code    string   `json:"code"`
+	Domains []string `json:"domains,omitempty"`
+	Queries int      `json:"queries"`
+}
+
+// AnalyticsDestinationCompany represents the number of queries resolved to one of the GAFAM companies.
+type AnalyticsDestinationCompany struct {
+	Company string `json:"company"`
+	Queries int    `json:"queries"`
+}
+
+// GetAnalyticsIPsRequest encapsulates the request for getting the queries by client IP.
+type GetAnalyticsIPsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsDevicesRequest encapsulates the request for getting the queries by device.
+type GetAnalyticsDevicesRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsProtocolsRequest encapsulates the request for getting the queries by protocol.
+type GetAnalyticsProtocolsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsQueryTypesRequest encapsulates the request for getting the queries by DNS record type.
+type GetAnalyticsQueryTypesRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsIPVersionsRequest encapsulates the request for getting the queries by IP version.
+type GetAnalyticsIPVersionsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsDNSSECRequest encapsulates the request for getting the queries by DNSSEC validation.
+type GetAnalyticsDNSSECRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsEncryptionRequest encapsulates the request for getting the queries by encryption.
+type GetAnalyticsEncryptionRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// GetAnalyticsDestinationsRequest encapsulates the request for getting the queries by destination.
+type GetAnalyticsDestinationsRequest struct {
+	ProfileID string
+	AnalyticsFilter
+}
+
+// destinationsQuery represents the query parameters of the destinations API, which requires the destination type.
+type destinationsQuery struct {
+	*GetAnalyticsDestinationsRequest
+	Type string `url:"type"`
+}
+
+// GetIPs returns the number of queries by client IP.
+func (s *analyticsService) GetIPs(ctx context.Context, request *GetAnalyticsIPsRequest) (*AnalyticsPage[*AnalyticsIP], error) {
+	ctx = withOperation(ctx, "Analytics", "GetIPs", request.ProfileID)
+	return getAnalytics[*AnalyticsIP](ctx, s.client, request.ProfileID, analyticsIPsAPIPath, request, "ips")
+}
+
+// GetDevices returns the number of queries by device.
+func (s *analyticsService) GetDevices(ctx context.Context, request *GetAnalyticsDevicesRequest) (*AnalyticsPage[*AnalyticsDevice], error) {
+	ctx = withOperation(ctx, "Analytics", "GetDevices", request.ProfileID)
+	return getAnalytics[*AnalyticsDevice](ctx, s.client, request.ProfileID, analyticsDevicesAPIPath, request, "devices")
+}
+
+// GetProtocols returns the number of queries by protocol.
+func (s *analyticsService) GetProtocols(ctx context.Context, request *GetAnalyticsProtocolsRequest) (*AnalyticsPage[*AnalyticsProtocol], error) {
+	ctx = withOperation(ctx, "Analytics", "GetProtocols", request.ProfileID)
+	return getAnalytics[*AnalyticsProtocol](ctx, s.client, request.ProfileID, analyticsProtocolsAPIPath, request, "protocols")
+}
+
+// GetQueryTypes returns the number of queries by DNS record type.
+func (s *analyticsService) GetQueryTypes(ctx context.Context, request *GetAnalyticsQueryTypesRequest) (*AnalyticsPage[*AnalyticsQueryType], error) {
+	ctx = withOperation(ctx, "Analytics", "GetQueryTypes", request.ProfileID)
+	return getAnalytics[*AnalyticsQueryType](ctx, s.client, request.ProfileID, analyticsQueryTypesAPIPath, request, "query types")
+}
+
+// GetIPVersions returns the number of queries by IP version.
+func (s *analyticsService) GetIPVersions(ctx context.Context, request *GetAnalyticsIPVersionsRequest) (*AnalyticsPage[*AnalyticsIPVersion], error) {
+	ctx = withOperation(ctx, "Analytics", "GetIPVersions", request.ProfileID)
+	return getAnalytics[*AnalyticsIPVersion](ctx, s.client, request.ProfileID, analyticsIPVersionsAPIPath, request, "ip versions")
+}
+
+// GetDNSSEC returns the number of queries by DNSSEC validation.
+func (s *analyticsService) GetDNSSEC(ctx context.Context, request *GetAnalyticsDNSSECRequest) (*AnalyticsPage[*AnalyticsDNSSEC], error) {
+	ctx = withOperation(ctx, "Analytics", "GetDNSSEC", request.ProfileID)
+	return getAnalytics[*AnalyticsDNSSEC](ctx, s.client, request.ProfileID, analyticsDNSSECAPIPath, request, "dnssec")
+}
+
+// GetEncryption returns the number of queries by encryption.
+func (s *analyticsService) GetEncryption(ctx context.Context, request *GetAnalyticsEncryptionRequest) (*AnalyticsPage[*AnalyticsEncryption], error) {
+	ctx = withOperation(ctx, "Analytics", "GetEncryption", request.ProfileID)
+	return getAnalytics[*AnalyticsEncryption](ctx, s.client, request.ProfileID, analyticsEncryptionAPIPath, request, "encryption")
+}
+
+// GetDestinationCountries returns the number of queries by destination country.
+func (s *analyticsService) GetDestinationCountries(ctx context.Context, request *GetAnalyticsDestinationsRequest) (*AnalyticsPage[*AnalyticsDestinationCountry], error) {
+	ctx = withOperation(ctx, "Analytics", "GetDestinationCountries", request.ProfileID)
+	query := &destinationsQuery{GetAnalyticsDestinationsRequest: request, Type: "countries"}
+	return getAnalytics[*AnalyticsDestinationCountry](ctx, s.client, request.ProfileID, analyticsDestinationsAPIPath, query, "destination countries")
+}
+
+// GetDestinationCompanies returns the number of queries by destination GAFAM company.
+func (s *analyticsService) GetDestinationCompanies(ctx context.Context, request *GetAnalyticsDestinationsRequest) (*AnalyticsPage[*AnalyticsDestinationCompany], error) {
+	ctx = withOperation(ctx, "Analytics", "GetDestinationCompanies", request.ProfileID)
+	query := &destinationsQuery{GetAnalyticsDestinationsRequest: request, Type: "gafam"}
+	return getAnalytics[*AnalyticsDestinationCompany](ctx, s.client, request.ProfileID, analyticsDestinationsAPIPath, query, "destination companies")
+}
@@ -0,0 +1,262 @@
+package nextdns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// AnalyticsDimension represents an analytics endpoint that can be queried as a time series.
+type AnalyticsDimension string
+
+// Dimensions of the analytics that can be queried as a time series.
+const (
+	AnalyticsDimensionStatus               AnalyticsDimension = "status"
+	AnalyticsDimensionDomains              AnalyticsDimension = "domains"
+	AnalyticsDimensionReasons              AnalyticsDimension = "reasons"
+	AnalyticsDimensionIPs                  AnalyticsDimension = "ips"
+	AnalyticsDimensionDevices              AnalyticsDimension = "devices"
+	AnalyticsDimensionProtocols            AnalyticsDimension = "protocols"
+	AnalyticsDimensionQueryTypes           AnalyticsDimension = "queryTypes"
+	AnalyticsDimensionIPVersions           AnalyticsDimension = "ipVersions"
+	AnalyticsDimensionDNSSEC               AnalyticsDimension = "dnssec"
+	AnalyticsDimensionEncryption           AnalyticsDimension = "encryption"
+	AnalyticsDimensionDestinationCountries AnalyticsDimension = "destinations:countries"
+	AnalyticsDimensionDestinationCompanies AnalyticsDimension = "destinations:gafam"
+)
+
+// analyticsSeries describes how to query a dimension as a time series.
+type analyticsSeries struct {
+	// path is the HTTP path of the series API.
+	path string
+
+	// key is the JSON field identifying the items of the dimension.
+	key string
+
+	// destination is the destination type, for the destinations API.
+	destination string
+}
+
+// analyticsSeriesDimensions maps the dimensions to their series APIs.
+var analyticsSeriesDimensions = map[AnalyticsDimension]analyticsSeries{
+	AnalyticsDimensionStatus:               {path: analyticsStatusAPIPath + ";series", key: "status"},
+	AnalyticsDimensionDomains:              {path: analyticsDomainsAPIPath + ";series", key: "domain"},
+	AnalyticsDimensionReasons:              {path: analyticsReasonsAPIPath + ";series", key: "id"},
+	AnalyticsDimensionIPs:                  {path: analyticsIPsAPIPath + ";series", key: "ip"},
+	AnalyticsDimensionDevices:              {path: analyticsDevicesAPIPath + ";series", key: "id"},
+	AnalyticsDimensionProtocols:            {path: analyticsProtocolsAPIPath + ";series", key: "protocol"},
+	AnalyticsDimensionQueryTypes:           {path: analyticsQueryTypesAPIPath + ";series", key: "name"},
+	AnalyticsDimensionIPVersions:           {path: analyticsIPVersionsAPIPath + ";series", key: "version"},
+	AnalyticsDimensionDNSSEC:               {path: analyticsDNSSECAPIPath + ";series", key: "validated"},
+	AnalyticsDimensionEncryption:           {path: analyticsEncryptionAPIPath + ";series", key: "encrypted"},
+	AnalyticsDimensionDestinationCountries: {path: analyticsDestinationsAPIPath + ";series", key: "code", destination: "countries"},
+	AnalyticsDimensionDestinationCompanies: {path: analyticsDestinationsAPIPath + ";series", key: "company", destination: "gafam"},
+}
+
+// GetAnalyticsSeriesRequest encapsulates the request for getting a dimension as a time series.
+type GetAnalyticsSeriesRequest struct {
+	ProfileID string
+	Dimension AnalyticsDimension
+	AnalyticsFilter
+
+	// Interval is the duration of each point of the series, or zero to let the API choose it.
+	Interval time.Duration `url:"interval,omitempty"`
+
+	// Alignment aligns the points on the "start" or "end" of the time range, or on the "clock".
+	Alignment string `url:"alignment,omitempty"`
+
+	// Timezone is the IANA timezone used to align the points on the clock (e.g. "Europe/Paris").
+	Timezone string `url:"timezone,omitempty"`
+}
+
+// seriesQuery represents the query parameters of a series API, with the destination type for the destinations.
+type seriesQuery struct {
+	*GetAnalyticsSeriesRequest
+	Type string `url:"type,omitempty"`
+}
+
+// TimeSeries represents the number of queries over time, for each key of a dimension.
+type TimeSeries struct {
+	// Times are the start times of the points of the series.
+	Times []time.Time
+
+	// Interval is the duration of each point of the series.
+	Interval time.Duration
+
+	// Values are the number of queries for each key, with one value per point of the series.
+	Values map[string][]int
+
+	// Cursor is the cursor of the next page of keys, or empty if there is no next page.
+	Cursor string
+}
+
+// TimePoint represents a point of a time series.
+type TimePoint struct {
+	Time  time.Time
+	Value int
+}
+
+// analyticsSeriesResponse represents the response of an analytics series endpoint.
+type analyticsSeriesResponse struct {
+	Data []map[string]json.RawMessage `json:"data"`
+	Meta struct {
+		Series struct {
+			Times    []time.Time `json:"times"`
+			Interval int         `json:"interval"`
+		} `json:"series"`
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// GetSeries returns the number of queries over time for each key of the requested dimension.
+func (s *analyticsService) GetSeries(ctx context.Context, request *GetAnalyticsSeriesRequest) (*TimeSeries, error) {
+	ctx = withOperation(ctx, "Analytics", "GetSeries", request.ProfileID)
+	series, ok := analyticsSeriesDimensions[request.Dimension]
+	if !ok {
+		return nil, fmt.Errorf("error creating request to get the analytics series: %w %q", ErrUnknownDimension, request.Dimension)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("error creating request to get the analytics series: %w", err)
+	}
+
+	query := &seriesQuery{GetAnalyticsSeriesRequest: request, Type: series.destination}
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), series.path)
+	req, err := s.client.newRequest(http.MethodGet, path, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to get the analytics series: %w", err)
+	}
+
+	response := analyticsSeriesResponse{}
+	err = s.client.do(ctx, req, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error making a request to get the analytics series: %w", err)
+	}
+
+	ts := &TimeSeries{
+		Times:    response.Meta.Series.Times,
+		Interval: time.Duration(response.Meta.Series.Interval) * time.Second,
+		Values:   make(map[string][]int, len(response.Data)),
+		Cursor:   response.Meta.Pagination.Cursor,
+	}
+	for _, item := range response.Data {
+		var values []int
+		if err := json.Unmarshal(item["queries"], &values); err != nil {
+			return nil, fmt.Errorf("error decoding the analytics series: %w", err)
+		}
+
+		ts.Values[seriesKey(item[series.key])] = values
+	}
+
+	return ts, nil
+}
+
+// seriesKey returns the key of a series item, unquoting the JSON strings.
+func seriesKey(raw json.RawMessage) string {
+	var key string
+	if json.Unmarshal(raw, &key) == nil {
+		return key
+	}
+
+	return string(raw)
+}
+
+// Keys returns the keys of the series, sorted.
+func (ts *TimeSeries) Keys() []string {
+	keys := make([]string, 0, len(ts.Values))
+	for key := range ts.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Sum returns the sum of the values of all the keys, for each point of the series.
+func (ts *TimeSeries) Sum() []int {
+	sum := make([]int, len(ts.Times))
+	for _, values := range ts.Values {
+		for i, v := range values {
+			if i < len(sum) {
+				sum[i] += v
+			}
+		}
+	}
+
+	return sum
+}
+
+// Points returns the values of the key indexed by time, or nil if the key is not in the series.
+func (ts *TimeSeries) Points(key string) []TimePoint {
+	values, ok := ts.Values[key]
+	if !ok {
+		return nil
+	}
+
+	return ts.points(values)
+}
+
+// SumPoints returns the sum of the values of all the keys indexed by time.
+func (ts *TimeSeries) SumPoints() []TimePoint {
+	return ts.points(ts.Sum())
+}
+
+// points indexes the values by the times of the series.
+func (ts *TimeSeries) points(values []int) []TimePoint {
+	points := make([]TimePoint, 0, len(values))
+	for i, v := range values {
+		if i >= len(ts.Times) {
+			break
+		}
+		points = append(points, TimePoint{Time: ts.Times[i], Value: v})
+	}
+
+	return points
+}
+
+// Resample returns a copy of the series with the points summed into buckets of the given interval,
+// starting at the first point of the series. Intervals shorter than the interval of the series can't be
+// resampled, and a copy of the series is returned as is.
+func (ts *TimeSeries) Resample(interval time.Duration) *TimeSeries {
+	out := &TimeSeries{
+		Interval: ts.Interval,
+		Values:   make(map[string][]int, len(ts.Values)),
+		Cursor:   ts.Cursor,
+	}
+	if interval <= ts.Interval || len(ts.Times) == 0 {
+		out.Times = append([]time.Time(nil), ts.Times...)
+		for key, values := range ts.Values {
+			out.Values[key] = append([]int(nil), values...)
+		}
+		return out
+	}
+
+	// Maps each point to its bucket.
+	start := ts.Times[0]
+	buckets := make([]int, len(ts.Times))
+	for i, t := range ts.Times {
+		buckets[i] = int(t.Sub(start) / interval)
+	}
+
+	count := buckets[len(buckets)-1] + 1
+	out.Interval = interval
+	out.Times = make([]time.Time, count)
+	for i := range out.Times {
+		out.Times[i] = start.Add(time.Duration(i) * interval)
+	}
+
+	for key, values := range ts.Values {
+		resampled := make([]int, count)
+		for i, v := range values {
+			if i < len(buckets) {
+				resampled[buckets[i]] += v
+			}
+		}
+		out.Values[key] = resampled
+	}
+
+	return out
+}
@@ -0,0 +1,86 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestAnalyticsGetSeries(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/status;series")
+		c.Equal(r.URL.Query().Get("interval"), "3600")
+		c.Equal(r.URL.Query().Get("alignment"), "clock")
+
+		_, err := w.Write([]byte(`{
+			"data":[{"status":"default","queries":[10,20,30]},{"status":"blocked","queries":[1,2,3]}],
+			"meta":{"series":{"times":["2023-01-01T00:00:00Z","2023-01-01T01:00:00Z","2023-01-01T02:00:00Z"],"interval":3600}}
+		}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	series, err := client.Analytics.GetSeries(context.Background(), &GetAnalyticsSeriesRequest{
+		ProfileID: "abc123",
+		Dimension: AnalyticsDimensionStatus,
+		Interval:  time.Hour,
+		Alignment: "clock",
+	})
+	c.NoErr(err)
+	c.Equal(series.Interval, time.Hour)
+	c.Equal(series.Keys(), []string{"blocked", "default"})
+	c.Equal(series.Sum(), []int{11, 22, 33})
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.Equal(series.Points("blocked"), []TimePoint{
+		{Time: start, Value: 1},
+		{Time: start.Add(time.Hour), Value: 2},
+		{Time: start.Add(2 * time.Hour), Value: 3},
+	})
+	c.Equal(series.Points("allowed"), nil)
+
+	resampled := series.Resample(2 * time.Hour)
+	c.Equal(resampled.Interval, 2*time.Hour)
+	c.Equal(resampled.Times, []time.Time{start, start.Add(2 * time.Hour)})
+	c.Equal(resampled.Values["default"], []int{30, 30})
+	c.Equal(resampled.SumPoints()[0].Value, 33)
+}
+
+func TestAnalyticsGetSeriesDimension(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/destinations;series")
+		c.Equal(r.URL.Query().Get("type"), "gafam")
+
+		_, err := w.Write([]byte(`{"data":[{"company":"apple","queries":[4,5]}],"meta":{"series":{"times":["2023-01-01T00:00:00Z","2023-01-02T00:00:00Z"],"interval":86400}}}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	series, err := client.Analytics.GetSeries(context.Background(), &GetAnalyticsSeriesRequest{
+		ProfileID: "abc123",
+		Dimension: AnalyticsDimensionDestinationCompanies,
+	})
+	c.NoErr(err)
+	c.Equal(series.Values, map[string][]int{"apple": {4, 5}})
+
+	_, err = client.Analytics.GetSeries(context.Background(), &GetAnalyticsSeriesRequest{
+		ProfileID: "abc123",
+		Dimension: "unknown",
+	})
+	c.True(errors.Is(err, ErrUnknownDimension))
+}
@@ -0,0 +1,176 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestAnalyticsGetStatus(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/status")
+		c.Equal(r.URL.Query().Get("from"), "2023-01-02T03:04:05Z")
+		c.Equal(r.URL.Query().Get("device"), "8TD1G")
+		c.Equal(r.URL.Query().Get("to"), "")
+
+		_, err := w.Write([]byte(`{"data":[{"status":"default","queries":120},{"status":"blocked","queries":30}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	status, err := client.Analytics.GetStatus(context.Background(), &GetAnalyticsStatusRequest{
+		ProfileID: "abc123",
+		AnalyticsFilter: AnalyticsFilter{
+			TimeRange: TimeRange{
+				From: At(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Device: "8TD1G",
+		},
+	})
+	c.NoErr(err)
+	c.Equal(status.Data, []*AnalyticsStatus{
+		{Status: "default", Queries: 120},
+		{Status: "blocked", Queries: 30},
+	})
+}
+
+func TestAnalyticsGetDomains(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/domains")
+		c.Equal(r.URL.Query().Get("status"), "blocked")
+		c.Equal(r.URL.Query().Get("root"), "true")
+		c.Equal(r.URL.Query().Get("limit"), "10")
+		c.Equal(r.URL.Query().Get("cursor"), "abc")
+
+		_, err := w.Write([]byte(`{"data":[{"domain":"app-measurement.com","root":"app-measurement.com","tracker":"google","queries":42}],"meta":{"pagination":{"cursor":"def"}}}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	domains, err := client.Analytics.GetDomains(context.Background(), &GetAnalyticsDomainsRequest{
+		ProfileID:       "abc123",
+		AnalyticsFilter: AnalyticsFilter{Limit: 10, Cursor: "abc"},
+		Status:          "blocked",
+		Root:            true,
+	})
+	c.NoErr(err)
+	c.Equal(domains.Data, []*AnalyticsDomain{
+		{Domain: "app-measurement.com", Root: "app-measurement.com", Tracker: "google", Queries: 42},
+	})
+	c.Equal(domains.Cursor, "def")
+}
+
+func TestAnalyticsGetReasons(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/reasons")
+
+		_, err := w.Write([]byte(`{"data":[{"id":"blocklist:nextdns-recommended","name":"NextDNS Ads & Trackers Blocklist","queries":15}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	reasons, err := client.Analytics.GetReasons(context.Background(), &GetAnalyticsReasonsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+	c.Equal(reasons.Data, []*AnalyticsReason{
+		{ID: "blocklist:nextdns-recommended", Name: "NextDNS Ads & Trackers Blocklist", Queries: 15},
+	})
+}
+
+func TestAnalyticsGetDevices(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/devices")
+		c.Equal(r.URL.Query().Get("limit"), "5")
+
+		_, err := w.Write([]byte(`{"data":[{"id":"8TD1G","name":"iPhone","model":"iPhone 12 Pro Max","localIp":"192.168.0.2","queries":489}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	devices, err := client.Analytics.GetDevices(context.Background(), &GetAnalyticsDevicesRequest{
+		ProfileID:       "abc123",
+		AnalyticsFilter: AnalyticsFilter{Limit: 5},
+	})
+	c.NoErr(err)
+	c.Equal(devices.Data, []*AnalyticsDevice{
+		{ID: "8TD1G", Name: "iPhone", Model: "iPhone 12 Pro Max", LocalIP: "192.168.0.2", Queries: 489},
+	})
+}
+
+func TestAnalyticsGetIPs(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/ips")
+
+		_, err := w.Write([]byte(`{"data":[{"ip":"91.171.12.34","network":{"cellular":false,"vpn":false,"isp":"Free SAS","asn":12322},"geo":{"latitude":48.85,"longitude":2.35,"countryCode":"FR","country":"France","city":"Paris"},"queries":136}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ips, err := client.Analytics.GetIPs(context.Background(), &GetAnalyticsIPsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+	c.Equal(len(ips.Data), 1)
+	c.Equal(ips.Data[0].Network.ISP, "Free SAS")
+	c.Equal(ips.Data[0].Geo.CountryCode, "FR")
+	c.Equal(ips.Data[0].Queries, 136)
+}
+
+func TestAnalyticsGetDestinations(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/analytics/destinations")
+
+		var err error
+		switch r.URL.Query().Get("type") {
+		case "countries":
+			_, err = w.Write([]byte(`{"data":[{"code":"US","domains":["apple.com"],"queries":90}]}`))
+		case "gafam":
+			_, err = w.Write([]byte(`{"data":[{"company":"apple","queries":40}]}`))
+		default:
+			t.Errorf("unexpected destinations type %q", r.URL.Query().Get("type"))
+		}
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	request := &GetAnalyticsDestinationsRequest{ProfileID: "abc123"}
+
+	countries, err := client.Analytics.GetDestinationCountries(context.Background(), request)
+	c.NoErr(err)
+	c.Equal(countries.Data, []*AnalyticsDestinationCountry{{Code: "US", Domains: []string{"apple.com"}, Queries: 90}})
+
+	companies, err := client.Analytics.GetDestinationCompanies(context.Background(), request)
+	c.NoErr(err)
+	c.Equal(companies.Data, []*AnalyticsDestinationCompany{{Company: "apple", Queries: 40}})
+}
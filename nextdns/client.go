@@ -5,11 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 )
@@ -22,8 +23,18 @@ const (
 
 // Client represents a NextDNS client.
 type Client struct {
-	client  *http.Client
-	baseURL *url.URL
+	client         *http.Client
+	baseURL        *url.URL
+	retryPolicy    *RetryPolicy
+	rateLimiter    *rateLimiter
+	logger         *slog.Logger
+	logBodies      bool
+	redactor       *redactor
+	apiKey         string
+	middlewares    []Middleware
+	transports     []Transport
+	operationHooks []OperationHook
+	handler        Handler
 
 	// Service for the Profile.
 	Profiles ProfilesService
@@ -59,7 +70,13 @@ type Client struct {
 	Setup         SetupService
 	SetupLinkedIP SetupLinkedIPService
 
-	// Debug mode for the HTTP requests.
+	// Services for the Analytics.
+	Analytics AnalyticsService
+
+	// Services for the Logs.
+	Logs LogsService
+
+	// Debug mode for the HTTP requests. It is checked on each request, so it can be set once the client is created.
 	Debug bool
 }
 
@@ -86,17 +103,13 @@ func WithAPIKey(apiKey string) ClientOption {
 			return ErrEmptyAPIToken
 		}
 
-		transport := authTransport{
-			rt:     c.client.Transport,
-			apiKey: apiKey,
-		}
-
-		c.client.Transport = &transport
+		c.apiKey = apiKey
 		return nil
 	}
 }
 
-// WithDebug enables debug mode.
+// WithDebug enables debug mode, logging the requests and responses with their bodies.
+// Events are written to the standard error output, unless a logger is set with WithLogger.
 func WithDebug() ClientOption {
 	return func(c *Client) error {
 		c.Debug = true
@@ -105,6 +118,7 @@ func WithDebug() ClientOption {
 }
 
 // WithHTTPClient sets a custom HTTP client that can be used for requests.
+// The client is not modified, and the options adding behavior to its transport (e.g. WithAPIKey) apply regardless of their order.
 func WithHTTPClient(client *http.Client) ClientOption {
 	return func(c *Client) error {
 		if client == nil {
@@ -124,8 +138,9 @@ func New(opts ...ClientOption) (*Client, error) {
 	}
 
 	c := &Client{
-		client:  cleanhttp.DefaultClient(),
-		baseURL: baseURL,
+		client:   cleanhttp.DefaultClient(),
+		baseURL:  baseURL,
+		redactor: newRedactor(),
 	}
 
 	for _, opt := range opts {
@@ -135,6 +150,10 @@ func New(opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	// Wraps the transport and the handler once all the options are set, so they can be given in any order.
+	c.client = c.wrapTransport(c.client)
+	c.handler = c.chainMiddlewares(c.execute)
+
 	// Initialize the services for the Profile.
 	c.Profiles = NewProfilesService(c)
 
@@ -169,38 +188,79 @@ func New(opts ...ClientOption) (*Client, error) {
 	c.Setup = NewSetupService(c)
 	c.SetupLinkedIP = NewSetupLinkedIPService(c)
 
+	// Initialize the services for the Analytics.
+	c.Analytics = NewAnalyticsService(c)
+
+	// Initialize the services for the Logs.
+	c.Logs = NewLogsService(c)
+
 	return c, nil
 }
 
-// do executes an HTTP request and decodes the response into v.
+// do executes an HTTP request through the middlewares and decodes the response into v.
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) error {
-	req = req.WithContext(ctx)
+	call := &Call{
+		Operation: operationFromContext(ctx),
+		Endpoint:  c.endpointTemplate(req.URL),
+		Request:   req,
+		Result:    v,
+	}
 
-	res, err := c.client.Do(req)
+	if call.Operation == nil {
+		call.Operation = &Operation{ProfileID: c.profileIDFromURL(req.URL)}
+	}
+
+	return c.handler(ctx, call)
+}
+
+// execute executes the HTTP request of a call and decodes the response into its result.
+func (c *Client) execute(ctx context.Context, call *Call) error {
+	req, v := call.Request, call.Result
+
+	c.logRequest(ctx, req)
+
+	start := time.Now()
+	res, err := c.send(context.WithValue(ctx, callKey{}, call), req)
 	if err != nil {
+		c.logFailure(ctx, req, time.Since(start), err)
 		return err
 	}
+	call.StatusCode = res.StatusCode
+
+	// The streamed responses are handed to the caller unread, unless they are errors.
+	if stream, ok := v.(*streamResult); ok && res.StatusCode < http.StatusBadRequest {
+		c.logResponse(ctx, res, nil, time.Since(start))
+		captureResponse(ctx, res, nil)
+		stream.res = res
+		return nil
+	}
 	defer res.Body.Close()
 
-	return c.handleResponse(ctx, res, v)
+	err = c.handleResponse(ctx, res, start, v)
+
+	// Adds the details of the request to the client errors.
+	var clientErr *Error
+	if errors.As(err, &clientErr) {
+		clientErr.StatusCode = res.StatusCode
+		clientErr.Method = req.Method
+		clientErr.Path = req.URL.Path
+		clientErr.resolveFields(payloadFromRequest(req))
+	}
+
+	return err
 }
 
 // handleResponse handles the response from the NextDNS API and decodes the response into v if provided.
 // The goal is to handle the common errors that can occur when making a request to the NextDNS API,
 // and also provide custom error responses for the client.
-func (c *Client) handleResponse(ctx context.Context, res *http.Response, v interface{}) error {
+func (c *Client) handleResponse(ctx context.Context, res *http.Response, start time.Time, v interface{}) error {
 	out, err := io.ReadAll(res.Body)
 	if err != nil {
 		return err
 	}
 
-	if c.Debug {
-		if string(out) == "" {
-			fmt.Printf("[DEBUG] RESPONSE: StatusCode:%d\n", res.StatusCode)
-		} else {
-			fmt.Printf("[DEBUG] RESPONSE: StatusCode:%d, Body:%v\n", res.StatusCode, string(out))
-		}
-	}
+	c.logResponse(ctx, res, out, time.Since(start))
+	captureResponse(ctx, res, out)
 
 	// If there is no response body, then we don't need to do anything.
 	if res.StatusCode == http.StatusNoContent {
@@ -209,13 +269,16 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 
 	// Sets some default additional informations that can be used by the client to debug the error.
 	meta := map[string]string{
-		"body":        string(out),
+		"body":        c.redactor.body(out),
 		"http_status": http.StatusText(res.StatusCode),
 	}
 
-	// If the response is not a 200, then we need to handle the error.
-	// TODO(amalucelli): Report the behavior to NextDNS, but there are errors that return HTTP 200 ("duplicate" case).
-	if res.StatusCode >= http.StatusBadRequest || strings.Contains(string(out), "\"errors\"") {
+	// The NextDNS API can return errors with HTTP 200 (e.g. "duplicate"), so the response body
+	// is also checked for an error envelope.
+	errorRes, isEnvelope := decodeErrorEnvelope(out)
+
+	// If the response is not a 200 or is an error envelope, then we need to handle the error.
+	if res.StatusCode >= http.StatusBadRequest || isEnvelope {
 		if res.StatusCode >= http.StatusInternalServerError {
 			return &Error{
 				Type:    ErrorTypeServiceError,
@@ -227,32 +290,28 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 
 		// Tries to handle the error response body from the NextDNS API,
 		// encapsulated in a client error.
-		errorRes := &ErrorResponse{}
-		err = json.Unmarshal(out, errorRes)
-		if err != nil {
-			var jsonErr *json.SyntaxError
-			if errors.As(err, &jsonErr) {
-				meta["err"] = jsonErr.Error()
-				return &Error{
-					Type:    ErrorTypeMalformed,
-					Message: errMalformedErrorBody,
-					Errors:  nil,
-					Meta:    meta,
+		if !isEnvelope {
+			errorRes = &ErrorResponse{}
+			err = json.Unmarshal(out, errorRes)
+			if err != nil {
+				var jsonErr *json.SyntaxError
+				if errors.As(err, &jsonErr) {
+					meta["err"] = jsonErr.Error()
+					return &Error{
+						Type:    ErrorTypeMalformed,
+						Message: errMalformedErrorBody,
+						Errors:  nil,
+						Meta:    meta,
+					}
 				}
+				return err
 			}
-			return err
 		}
 
-		// Sets custom error messages for the client based on the HTTP status code.
-		var errType ErrorType
-
-		switch res.StatusCode {
-		case http.StatusForbidden:
-			errType = ErrorTypeAuthentication
-		case http.StatusNotFound:
-			errType = ErrorTypeNotFound
-		default:
-			errType = ErrorTypeRequest
+		// Sets custom error messages for the client based on the HTTP status code and the error codes.
+		errType := errorTypeFromStatus(res.StatusCode)
+		if errorRes.hasCode(errorCodeDuplicate) {
+			errType = ErrorTypeDuplicate
 		}
 
 		// Returns the error response from the NextDNS API encapsulated in a client error.
@@ -288,20 +347,28 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 	return nil
 }
 
-// newRequest creates a new HTTP request.
-func (c *Client) newRequest(method string, path string, body interface{}) (*http.Request, error) {
+// newRequest creates a new HTTP request, with the query parameters encoded from the struct tags of query, if any.
+func (c *Client) newRequest(method string, path string, query interface{}, body interface{}) (*http.Request, error) {
 	u, err := c.baseURL.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
+	values, err := encodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		u.RawQuery = values.Encode()
+	}
+
+	// Keeps the payload of the request, so the errors can be mapped to its fields.
+	ctx := context.WithValue(context.Background(), payloadKey{}, body)
+
 	var req *http.Request
 	switch method {
 	case http.MethodGet:
-		if c.Debug {
-			fmt.Printf("[DEBUG] REQUEST: Method:%s, URL:%s\n", method, u.String())
-		}
-		req, err = http.NewRequest(method, u.String(), nil)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -313,14 +380,7 @@ func (c *Client) newRequest(method string, path string, body interface{}) (*http
 				return nil, err
 			}
 		}
-		if c.Debug {
-			if buf.String() == "" {
-				fmt.Printf("[DEBUG] REQUEST: Method:%s, URL:%s\n", method, u.String())
-			} else {
-				fmt.Printf("[DEBUG] REQUEST: Method:%s, URL:%s, Body:%s\n", method, u.String(), strings.TrimSuffix(buf.String(), "\n"))
-			}
-		}
-		req, err = http.NewRequest(method, u.String(), buf)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), buf)
 		if err != nil {
 			return nil, err
 		}
@@ -333,14 +393,72 @@ func (c *Client) newRequest(method string, path string, body interface{}) (*http
 	return req, nil
 }
 
+// payloadKey is the context key for the payload of a request.
+type payloadKey struct{}
+
+// payloadFromRequest returns the payload the request body was encoded from, if any.
+func payloadFromRequest(req *http.Request) interface{} {
+	return req.Context().Value(payloadKey{})
+}
+
+// profileIDFromURL returns the profile ID targeted by a request URL, if any.
+func (c *Client) profileIDFromURL(u *url.URL) string {
+	path := strings.TrimPrefix(u.Path, c.baseURL.Path)
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) >= 2 && parts[0] == profilesAPIPath {
+		return parts[1]
+	}
+
+	return ""
+}
+
+// wrapTransport returns a copy of the HTTP client with its transport wrapped by the authTransport, and by the
+// transports of the client around it.
+func (c *Client) wrapTransport(client *http.Client) *http.Client {
+	if c.apiKey == "" && len(c.transports) == 0 {
+		return client
+	}
+
+	wrapped := *client
+	rt := wrapped.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	if c.apiKey != "" {
+		rt = &authTransport{
+			rt:     rt,
+			apiKey: c.apiKey,
+			host:   c.baseURL.Host,
+		}
+	}
+
+	for i := len(c.transports) - 1; i >= 0; i-- {
+		rt = c.transports[i](rt)
+	}
+	wrapped.Transport = rt
+
+	return &wrapped
+}
+
 // authHeader represents a RoundTripper that adds an authorization header to the request.
 type authTransport struct {
 	rt     http.RoundTripper
 	apiKey string
+
+	// host is the host of the API, the key is not sent to the other hosts (e.g. the redirects of the downloads).
+	host string
 }
 
 // RoundTrip adds the authorization header to requests.
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Add("X-Api-Key", t.apiKey)
-	return t.rt.RoundTrip(req)
+	rt := t.rt
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	if req.URL.Host == t.host {
+		req.Header.Set("X-Api-Key", t.apiKey)
+	}
+	return rt.RoundTrip(req)
 }
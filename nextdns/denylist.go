@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -19,6 +20,9 @@ type Denylist struct {
 type CreateDenylistRequest struct {
 	ProfileID string
 	Denylist  []*Denylist
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // ListDenylistRequest encapsulates the request for getting a denylist.
@@ -33,11 +37,54 @@ type UpdateDenylistRequest struct {
 	Denylist  *Denylist
 }
 
+// AddDenylistRequest encapsulates the request for adding a domain to a denylist.
+type AddDenylistRequest struct {
+	ProfileID string
+	Denylist  *Denylist
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeleteDenylistRequest encapsulates the request for deleting a domain from a denylist.
+type DeleteDenylistRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
+// AddDenylistEntriesRequest encapsulates the request for adding domains to the denylist, keeping the other domains.
+type AddDenylistEntriesRequest struct {
+	ProfileID string
+	Denylist  []*Denylist
+}
+
+// RemoveDenylistEntriesRequest encapsulates the request for removing domains from the denylist, keeping the other domains.
+type RemoveDenylistEntriesRequest struct {
+	ProfileID string
+	IDs       []string
+}
+
+// SetDenylistActiveRequest encapsulates the request for activating or deactivating domains of the denylist.
+type SetDenylistActiveRequest struct {
+	ProfileID string
+	IDs       []string
+	Active    bool
+}
+
 // DenylistService is an interface for communicating with the NextDNS denylist API endpoint.
 type DenylistService interface {
 	Create(context.Context, *CreateDenylistRequest) error
 	List(context.Context, *ListDenylistRequest) ([]*Denylist, error)
+	All(context.Context, *ListDenylistRequest) iter.Seq2[*Denylist, error]
 	Update(context.Context, *UpdateDenylistRequest) error
+	Add(context.Context, *AddDenylistRequest) error
+	Delete(context.Context, *DeleteDenylistRequest) error
+	AddEntries(context.Context, *AddDenylistEntriesRequest) error
+	RemoveEntries(context.Context, *RemoveDenylistEntriesRequest) error
+	SetActive(context.Context, *SetDenylistActiveRequest) error
 }
 
 // denylistResponse represents the denylist response.
@@ -61,14 +108,16 @@ func NewDenylistService(client *Client) *denylistService {
 }
 
 // Create creates a denylist for a profile.
+// It replaces the whole denylist, use AddEntries to add domains while keeping the others.
 func (s *denylistService) Create(ctx context.Context, request *CreateDenylistRequest) error {
+	ctx = withOperation(ctx, "Denylist", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), denylistAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.Denylist)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.Denylist)
 	if err != nil {
 		return fmt.Errorf("error creating request to create an deny list: %w", err)
 	}
 
-	err = s.client.do(ctx, req, nil)
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create an deny list: %w", err)
 	}
@@ -78,8 +127,9 @@ func (s *denylistService) Create(ctx context.Context, request *CreateDenylistReq
 
 // List returns the denylist of a profile.
 func (s *denylistService) List(ctx context.Context, request *ListDenylistRequest) ([]*Denylist, error) {
+	ctx = withOperation(ctx, "Denylist", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), denylistAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the deny list: %w", err)
 	}
@@ -93,10 +143,18 @@ func (s *denylistService) List(ctx context.Context, request *ListDenylistRequest
 	return response.Denylist, nil
 }
 
+// All returns an iterator over the denylist of a profile.
+func (s *denylistService) All(ctx context.Context, request *ListDenylistRequest) iter.Seq2[*Denylist, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*Denylist, error) {
+		return s.List(ctx, request)
+	})
+}
+
 // Update updates a denylist of a profile.
 func (s *denylistService) Update(ctx context.Context, request *UpdateDenylistRequest) error {
+	ctx = withOperation(ctx, "Denylist", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), denylistIDAPIPath(request.ID))
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Denylist)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Denylist)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the deny list id %s: %w", request.ID, err)
 	}
@@ -109,7 +167,120 @@ func (s *denylistService) Update(ctx context.Context, request *UpdateDenylistReq
 	return nil
 }
 
+// Add adds a domain to the denylist of a profile.
+func (s *denylistService) Add(ctx context.Context, request *AddDenylistRequest) error {
+	ctx = withOperation(ctx, "Denylist", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), denylistAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.Denylist)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the deny list: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the deny list: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a domain from the denylist of a profile.
+func (s *denylistService) Delete(ctx context.Context, request *DeleteDenylistRequest) error {
+	ctx = withOperation(ctx, "Denylist", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), denylistIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the deny list id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the deny list id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// AddEntries adds domains to the denylist of a profile. Unlike Create, which replaces the whole list, only the
+// missing domains and the ones whose settings differ are sent, so the other domains are left as they are.
+func (s *denylistService) AddEntries(ctx context.Context, request *AddDenylistEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "Denylist", "AddEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).addEntries(ctx, request.Denylist)
+	if err != nil {
+		return fmt.Errorf("error adding the entries of the deny list: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntries removes domains from the denylist of a profile, only deleting the ones that are in the list.
+func (s *denylistService) RemoveEntries(ctx context.Context, request *RemoveDenylistEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "Denylist", "RemoveEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).removeEntries(ctx, request.IDs)
+	if err != nil {
+		return fmt.Errorf("error removing the entries of the deny list: %w", err)
+	}
+
+	return nil
+}
+
+// SetActive activates or deactivates domains of the denylist of a profile, only updating the ones that differ.
+// It returns ErrNotFound, without updating any of them, when some domains are not in the list.
+func (s *denylistService) SetActive(ctx context.Context, request *SetDenylistActiveRequest) (err error) {
+	ctx = withOperation(ctx, "Denylist", "SetActive", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).setActiveEntries(ctx, request.IDs, request.Active)
+	if err != nil {
+		return fmt.Errorf("error setting the active entries of the deny list: %w", err)
+	}
+
+	return nil
+}
+
+// merge returns the per-item operations used to merge changes into the denylist of a profile.
+func (s *denylistService) merge(profileID string) listMerge[Denylist] {
+	return listMerge[Denylist]{
+		list: func(ctx context.Context) ([]*Denylist, error) {
+			return s.List(ctx, &ListDenylistRequest{ProfileID: profileID})
+		},
+		add: func(ctx context.Context, item *Denylist) error {
+			return s.Add(ctx, &AddDenylistRequest{ProfileID: profileID, Denylist: item, IgnoreDuplicates: true})
+		},
+		update: func(ctx context.Context, item *Denylist) error {
+			return s.Update(ctx, &UpdateDenylistRequest{ProfileID: profileID, ID: item.ID, Denylist: item})
+		},
+		remove: func(ctx context.Context, id string) error {
+			return s.Delete(ctx, &DeleteDenylistRequest{ProfileID: profileID, ID: id, IgnoreNotFound: true})
+		},
+		id: func(item *Denylist) string {
+			return item.ID
+		},
+		equal: func(a *Denylist, b *Denylist) bool {
+			return *a == *b
+		},
+		setActive: func(item *Denylist, active bool) *Denylist {
+			updated := *item
+			updated.Active = active
+			return &updated
+		},
+	}
+}
+
 // denylistIDAPIPath returns the HTTP path for the denylist API.
 func denylistIDAPIPath(id string) string {
-	return fmt.Sprintf("%s/%s", denylistAPIPath, id)
+	return itemAPIPath(denylistAPIPath, id)
 }
@@ -2,8 +2,11 @@ package nextdns
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/matryer/is"
@@ -97,3 +100,144 @@ func TestDenylistUpdate(t *testing.T) {
 
 	c.NoErr(err)
 }
+
+func TestDenylistDelete(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Method, http.MethodDelete)
+
+		switch r.URL.EscapedPath() {
+		case "/profiles/abc123/denylist/whatsapp.net":
+			w.WriteHeader(http.StatusNoContent)
+		case "/profiles/abc123/denylist/..%2Frewrites":
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"errors":[{"code":"notFound"}]}`))
+			c.NoErr(err)
+		default:
+			t.Errorf("unexpected path %s", r.URL.EscapedPath())
+		}
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+
+	err = client.Denylist.Delete(ctx, &DeleteDenylistRequest{ProfileID: "abc123", ID: "whatsapp.net"})
+	c.NoErr(err)
+
+	// The ID is escaped, so it can't change the path of the request.
+	err = client.Denylist.Delete(ctx, &DeleteDenylistRequest{ProfileID: "abc123", ID: "../rewrites"})
+	c.True(errors.Is(err, ErrNotFound))
+
+	err = client.Denylist.Delete(ctx, &DeleteDenylistRequest{ProfileID: "abc123", ID: "../rewrites", IgnoreNotFound: true})
+	c.NoErr(err)
+}
+
+func TestDenylistAddEntries(t *testing.T) {
+	c := is.New(t)
+
+	calls := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"whatsapp.net","active":true},{"id":"tiktok.com","active":false}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		c.NoErr(err)
+		calls = append(calls, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(body)))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Denylist.AddEntries(context.Background(), &AddDenylistEntriesRequest{
+		ProfileID: "abc123",
+		Denylist: []*Denylist{
+			{ID: "whatsapp.net", Active: true},
+			{ID: "tiktok.com", Active: true},
+			nil,
+			{ID: "facebook.com", Active: true},
+		},
+	})
+	c.NoErr(err)
+
+	// The unchanged domain and the nil entry are skipped, and the other domains of the list are left as they are.
+	c.Equal(calls, []string{
+		`PATCH /profiles/abc123/denylist/tiktok.com {"id":"tiktok.com","active":true}`,
+		`POST /profiles/abc123/denylist {"id":"facebook.com","active":true}`,
+	})
+}
+
+func TestDenylistRemoveEntries(t *testing.T) {
+	c := is.New(t)
+
+	calls := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"whatsapp.net","active":true},{"id":"tiktok.com","active":false}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Denylist.RemoveEntries(context.Background(), &RemoveDenylistEntriesRequest{
+		ProfileID: "abc123",
+		IDs:       []string{"tiktok.com", "facebook.com"},
+	})
+	c.NoErr(err)
+	c.Equal(calls, []string{"DELETE /profiles/abc123/denylist/tiktok.com"})
+}
+
+func TestDenylistSetActive(t *testing.T) {
+	c := is.New(t)
+
+	calls := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"whatsapp.net","active":true},{"id":"tiktok.com","active":false}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+
+	err = client.Denylist.SetActive(ctx, &SetDenylistActiveRequest{
+		ProfileID: "abc123",
+		IDs:       []string{"whatsapp.net", "tiktok.com"},
+		Active:    true,
+	})
+	c.NoErr(err)
+	c.Equal(calls, []string{"PATCH /profiles/abc123/denylist/tiktok.com"})
+
+	// Nothing is updated when a domain is not in the list.
+	calls = []string{}
+	err = client.Denylist.SetActive(ctx, &SetDenylistActiveRequest{
+		ProfileID: "abc123",
+		IDs:       []string{"whatsapp.net", "facebook.com"},
+		Active:    false,
+	})
+	c.True(errors.Is(err, ErrNotFound))
+	c.Equal(len(calls), 0)
+}
@@ -0,0 +1,49 @@
+package nextdns
+
+import (
+	"net/url"
+	"strings"
+)
+
+// endpointOther is the endpoint of the requests that are not made to the NextDNS API.
+const endpointOther = "other"
+
+// itemAPIPaths are the API paths of the lists whose items are addressed by ID.
+var itemAPIPaths = []string{
+	allowlistAPIPath,
+	denylistAPIPath,
+	parentalControlServicesAPIPath,
+	parentalControlCategoriesAPIPath,
+	privacyBlocklistsAPIPath,
+	privacyNativesAPIPath,
+	securityTldsAPIPath,
+	rewritesAPIPath,
+}
+
+// endpointTemplate returns the templated path of a request URL, without any profile or item ID.
+// The URLs outside of the NextDNS API are all templated as "other", so their paths never end up in the labels.
+func (c *Client) endpointTemplate(u *url.URL) string {
+	if u.Host != c.baseURL.Host || !strings.HasPrefix(u.Path, c.baseURL.Path) {
+		return endpointOther
+	}
+
+	path := strings.TrimPrefix(strings.TrimPrefix(u.Path, c.baseURL.Path), "/")
+	if path == profilesAPIPath {
+		return path
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[0] != profilesAPIPath {
+		return endpointOther
+	}
+
+	parts[1] = ":id"
+	rest := strings.Join(parts[2:], "/")
+	for _, itemPath := range itemAPIPaths {
+		if strings.HasPrefix(rest, itemPath+"/") {
+			return strings.Join([]string{profilesAPIPath, ":id", itemPath, ":item"}, "/")
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,35 @@
+package nextdns
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	c := is.New(t)
+
+	client, err := New(WithBaseURL("https://example.com/v1/"))
+	c.NoErr(err)
+
+	tests := map[string]string{
+		"/v1/status":                                    "other",
+		"/v1/profiles":                                  "profiles",
+		"/v1/profiles/abc123":                           "profiles/:id",
+		"/v1/profiles/abc123/security":                  "profiles/:id/security",
+		"/v1/profiles/abc123/security/tlds/ru":          "profiles/:id/security/tlds/:item",
+		"/v1/profiles/abc123/parentalControl/services":  "profiles/:id/parentalControl/services",
+		"/v1/profiles/abc123/rewrites/123abc":           "profiles/:id/rewrites/:item",
+		"/v1/profiles/abc123/privacy/blocklists/oisd":   "profiles/:id/privacy/blocklists/:item",
+		"/v1/profiles/abc123/denylist/www.example.com":  "profiles/:id/denylist/:item",
+		"/v1/profiles/abc123/allowlist/www.example.com": "profiles/:id/allowlist/:item",
+	}
+
+	for path, want := range tests {
+		c.Equal(client.endpointTemplate(&url.URL{Host: "example.com", Path: path}), want)
+	}
+
+	// The paths of the other hosts, like the redirects of the logs downloads, are never used.
+	c.Equal(client.endpointTemplate(&url.URL{Host: "storage.example.net", Path: "/v1/profiles/abc123/logs.csv"}), "other")
+}
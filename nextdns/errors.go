@@ -1,15 +1,34 @@
 package nextdns
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
 	"strings"
 )
 
 // ErrorType defines the code of an error.
 type ErrorType string
 
-var ErrEmptyAPIToken = errors.New("api key must not be empty")
+var (
+	ErrEmptyAPIToken    = errors.New("api key must not be empty")
+	ErrInvalidRateLimit = errors.New("rate limit and burst must be positive")
+	ErrInvalidTimeRange = errors.New("invalid time range")
+	ErrUnknownDimension = errors.New("unknown analytics dimension")
+	ErrInvalidQuery     = errors.New("invalid query parameters")
+)
+
+// Sentinel errors that can be matched with errors.Is against the errors returned by the services.
+var (
+	ErrNotFound     = errors.New("resource not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrDuplicate    = errors.New("duplicate resource")
+	ErrValidation   = errors.New("validation failed")
+)
 
 const (
 	errInternalServiceError = "internal service error received"
@@ -18,23 +37,34 @@ const (
 	errMalformedErrorBody   = "malformed error response body received"
 )
 
+// errorCodeDuplicate is the error code returned by the NextDNS API for duplicate resources.
+const errorCodeDuplicate = "duplicate"
+
 const (
 	ErrorTypeServiceError   ErrorType = "service_error"  // Internal service error.
 	ErrorTypeRequest        ErrorType = "request"        // Regular request error.
 	ErrorTypeMalformed      ErrorType = "malformed"      // Response body is malformed.
 	ErrorTypeAuthentication ErrorType = "authentication" // Authentication error.
 	ErrorTypeNotFound       ErrorType = "not_found"      // Resource not found.
+	ErrorTypeRateLimited    ErrorType = "rate_limited"   // Too many requests.
+	ErrorTypeDuplicate      ErrorType = "duplicate"      // Resource already exists.
 )
 
+// APIError represents an error entry of the error response from the NextDNS API.
+type APIError struct {
+	Code   string `json:"code"`
+	Detail string `json:"detail,omitempty"`
+	Source struct {
+		Parameter string `json:"parameter,omitempty"`
+	} `json:"source,omitempty"`
+
+	// Field is the Go field of the request matching Source.Parameter (e.g. "Settings.Logs.Retention"), if any.
+	Field string `json:"-"`
+}
+
 // ErrorResponse represents the error response from the NextDNS API.
 type ErrorResponse struct {
-	Errors []struct {
-		Code   string `json:"code"`
-		Detail string `json:"detail,omitempty"`
-		Source struct {
-			Parameter string `json:"parameter,omitempty"`
-		} `json:"source,omitempty"`
-	} `json:"errors"`
+	Errors []APIError `json:"errors"`
 }
 
 // Error represents the error from the Client.
@@ -43,25 +73,194 @@ type Error struct {
 	Message string
 	Errors  *ErrorResponse
 	Meta    map[string]string
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Method and Path are the HTTP method and path of the request.
+	Method string
+	Path   string
 }
 
 // Error returns the string representation of the error.
-// TODO(amalucelli): This is still not the best way to handle multiple errors, make this better at some point.
 func (e *Error) Error() string {
+	entries := e.APIErrors()
+	if len(entries) == 0 {
+		return e.Message
+	}
+
+	details := make([]string, 0, len(entries))
+	for _, er := range entries {
+		detail := er.Code
+		if er.Detail != "" {
+			detail = fmt.Sprintf("%s (%s)", er.Detail, er.Code)
+		}
+		if er.Source.Parameter != "" {
+			detail = fmt.Sprintf("%s: %s", er.Source.Parameter, detail)
+		}
+		details = append(details, detail)
+	}
+
+	return fmt.Sprintf("%s (%s): %s", e.Message, e.Type, strings.Join(details, "; "))
+}
+
+// APIErrors returns the error entries of the error response from the NextDNS API, if any.
+func (e *Error) APIErrors() []APIError {
+	if e.Errors == nil {
+		return nil
+	}
+
+	return e.Errors.Errors
+}
+
+// Is reports whether the error matches one of the sentinel errors, so it can be used with errors.Is.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Type == ErrorTypeNotFound || e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Type == ErrorTypeAuthentication || errorTypeFromStatus(e.StatusCode) == ErrorTypeAuthentication
+	case ErrRateLimited:
+		return e.Type == ErrorTypeRateLimited || e.StatusCode == http.StatusTooManyRequests
+	case ErrDuplicate:
+		return e.Type == ErrorTypeDuplicate || e.Errors.hasCode(errorCodeDuplicate)
+	case ErrValidation:
+		return e.Type == ErrorTypeRequest && len(e.APIErrors()) > 0
+	default:
+		return false
+	}
+}
+
+// hasCode reports whether one of the error entries has the given code.
+func (r *ErrorResponse) hasCode(code string) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, er := range r.Errors {
+		if er.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decodeErrorEnvelope decodes the response body as an error envelope, i.e. a JSON object with a
+// non-empty top-level "errors" array. Data that merely contains the word "errors" is not an envelope.
+func decodeErrorEnvelope(body []byte) (*ErrorResponse, bool) {
+	errorRes := &ErrorResponse{}
+	err := json.Unmarshal(body, errorRes)
+	if err != nil || len(errorRes.Errors) == 0 {
+		return nil, false
+	}
+
+	return errorRes, true
+}
+
+// ignoreDuplicate returns nil if the error is a duplicate error and duplicates should be ignored.
+func ignoreDuplicate(err error, ignore bool) error {
+	if ignore && errors.Is(err, ErrDuplicate) {
+		return nil
+	}
+
+	return err
+}
+
+// ignoreNotFound returns nil if the error is a not found error and missing resources should be ignored.
+func ignoreNotFound(err error, ignore bool) error {
+	if ignore && errors.Is(err, ErrNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// resolveFields maps the parameters of the error entries to the Go fields of the request payload.
+func (e *Error) resolveFields(payload interface{}) {
+	if payload == nil || e.Errors == nil {
+		return
+	}
+
+	for i := range e.Errors.Errors {
+		er := &e.Errors.Errors[i]
+		if er.Source.Parameter != "" {
+			er.Field = fieldPath(reflect.TypeOf(payload), er.Source.Parameter)
+		}
+	}
+}
+
+// errorTypeFromStatus returns the error type for an HTTP status code.
+func errorTypeFromStatus(statusCode int) ErrorType {
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		return ErrorTypeServiceError
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrorTypeAuthentication
+	case statusCode == http.StatusNotFound:
+		return ErrorTypeNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorTypeRateLimited
+	default:
+		return ErrorTypeRequest
+	}
+}
+
+// fieldPath returns the Go field path matching a JSON parameter (e.g. "settings.logs.retention") in a type,
+// or an empty string if the parameter doesn't match any field.
+func fieldPath(t reflect.Type, parameter string) string {
+	segments := strings.FieldsFunc(parameter, func(r rune) bool {
+		return r == '.' || r == '/'
+	})
+
 	var out strings.Builder
+	for _, segment := range segments {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
 
-	if e.Errors.Errors != nil && len(e.Errors.Errors) > 0 {
-		out.WriteString(fmt.Sprintf("%s (%s): ", e.Message, e.Type))
-		for _, er := range e.Errors.Errors {
-			if er.Detail != "" {
-				out.WriteString(fmt.Sprintf("%s (%s)", er.Detail, er.Code))
-			} else {
-				out.WriteString(er.Code)
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			_, err := strconv.Atoi(segment)
+			if err != nil {
+				return ""
+			}
+			out.WriteString("[" + segment + "]")
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := jsonField(t, segment)
+			if !ok {
+				return ""
+			}
+			if out.Len() > 0 {
+				out.WriteString(".")
 			}
+			out.WriteString(field.Name)
+			t = field.Type
+		default:
+			return ""
 		}
-	} else {
-		out.WriteString(e.Message)
 	}
 
 	return out.String()
 }
+
+// jsonField returns the field of a struct type encoded with the given JSON name.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		if tag == name || (tag == "" && strings.EqualFold(field.Name, name)) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,158 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestErrorSentinels(t *testing.T) {
+	c := is.New(t)
+
+	tests := []struct {
+		status int
+		body   string
+		target error
+	}{
+		{http.StatusNotFound, `{"errors":[{"code":"notFound"}]}`, ErrNotFound},
+		{http.StatusForbidden, `{"errors":[{"code":"forbidden"}]}`, ErrUnauthorized},
+		{http.StatusUnauthorized, `{"errors":[{"code":"unauthorized"}]}`, ErrUnauthorized},
+		{http.StatusTooManyRequests, `Too Many Requests`, ErrRateLimited},
+		{http.StatusBadRequest, `{"errors":[{"code":"duplicate"}]}`, ErrDuplicate},
+		{http.StatusBadRequest, `{"errors":[{"code":"invalid","source":{"parameter":"name"}}]}`, ErrValidation},
+	}
+
+	for _, tt := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			_, err := w.Write([]byte(tt.body))
+			c.NoErr(err)
+		}))
+
+		client, err := New(WithBaseURL(ts.URL))
+		c.NoErr(err)
+
+		_, err = client.Profiles.Get(context.Background(), &GetProfileRequest{ProfileID: "abc123"})
+		c.True(errors.Is(err, tt.target))
+
+		var clientErr *Error
+		c.True(errors.As(err, &clientErr))
+		c.Equal(clientErr.StatusCode, tt.status)
+		c.Equal(clientErr.Method, http.MethodGet)
+		c.Equal(clientErr.Path, "/profiles/abc123")
+
+		ts.Close()
+	}
+}
+
+func TestErrorServiceError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	_, err = client.Profiles.Get(context.Background(), &GetProfileRequest{ProfileID: "abc123"})
+
+	var clientErr *Error
+	c.True(errors.As(err, &clientErr))
+	c.Equal(clientErr.Type, ErrorTypeServiceError)
+	c.Equal(clientErr.Error(), errInternalServiceError)
+	c.True(!errors.Is(err, ErrNotFound))
+	c.True(!errors.Is(err, ErrValidation))
+}
+
+func TestErrorFields(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		out := `{"errors":[{"code":"invalid","source":{"parameter":"settings.logs.retention"}},{"code":"invalid","source":{"parameter":"denylist.1.id"}},{"code":"invalid","source":{"parameter":"unknown"}}]}`
+		_, err := w.Write([]byte(out))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	_, err = client.Profiles.Create(context.Background(), &CreateProfileRequest{Name: "foo"})
+	c.True(errors.Is(err, ErrValidation))
+
+	var clientErr *Error
+	c.True(errors.As(err, &clientErr))
+
+	entries := clientErr.APIErrors()
+	c.Equal(len(entries), 3)
+	c.Equal(entries[0].Field, "Settings.Logs.Retention")
+	c.Equal(entries[1].Field, "Denylist[1].ID")
+	c.Equal(entries[2].Field, "")
+	c.Equal(clientErr.Error(), "response error received (request): settings.logs.retention: invalid; denylist.1.id: invalid; unknown: invalid")
+}
+
+func TestErrorDuplicateWithStatusOK(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"errors":[{"code":"duplicate","source":{"parameter":"name"}}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+	request := &CreateRewritesRequest{
+		ProfileID: "abc123",
+		Rewrites:  &Rewrites{Name: "example.com", Content: "1.2.3.4"},
+	}
+
+	_, err = client.Rewrites.Create(ctx, request)
+	c.True(errors.Is(err, ErrDuplicate))
+	c.True(!errors.Is(err, ErrValidation))
+
+	var clientErr *Error
+	c.True(errors.As(err, &clientErr))
+	c.Equal(clientErr.Type, ErrorTypeDuplicate)
+	c.Equal(clientErr.APIErrors()[0].Field, "Name")
+
+	request.IgnoreDuplicates = true
+	id, err := client.Rewrites.Create(ctx, request)
+	c.NoErr(err)
+	c.Equal(id, "")
+
+	err = client.Denylist.Create(ctx, &CreateDenylistRequest{
+		ProfileID:        "abc123",
+		Denylist:         []*Denylist{{ID: "apple.com", Active: true}},
+		IgnoreDuplicates: true,
+	})
+	c.NoErr(err)
+}
+
+func TestErrorEnvelopeData(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"data":[{"id":"errors","active":true},{"id":"\"errors\"","active":false}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	list, err := client.Denylist.List(context.Background(), &ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+	c.Equal(list, []*Denylist{{ID: "errors", Active: true}, {ID: "\"errors\"", Active: false}})
+}
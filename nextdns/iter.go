@@ -0,0 +1,69 @@
+package nextdns
+
+import (
+	"context"
+	"iter"
+)
+
+// listSeq returns an iterator over the items of a list endpoint that returns all its items at once.
+func listSeq[T any](ctx context.Context, list func(context.Context) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		items, err := list(ctx)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// pageSeq returns an iterator over the items of a cursor-paginated endpoint, fetching the pages as the items are consumed.
+// The fetch function returns the items of the page at the cursor, along with the cursor of the next page.
+// Each range over the iterator starts again from the first cursor, and is a single operation for the operation hooks.
+func pageSeq[T any](ctx context.Context, c *Client, first string, fetch func(ctx context.Context, cursor string) ([]T, string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var err error
+		ctx, end := c.startOperation(ctx)
+		defer func() {
+			end(err)
+		}()
+
+		var zero T
+		cursor := first
+		seen := map[string]bool{cursor: true}
+
+		for {
+			if err = ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var items []T
+			var next string
+			items, next, err = fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// Stops when there is no next page, or when the API returns a cursor already followed.
+			if next == "" || seen[next] {
+				return
+			}
+			seen[next] = true
+			cursor = next
+		}
+	}
+}
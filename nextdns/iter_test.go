@@ -0,0 +1,183 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestListIterator(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":[{"id":"whatsapp.net","active":true},{"id":"apple.com","active":false}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var ids []string
+	for entry, err := range client.Denylist.All(context.Background(), &ListDenylistRequest{ProfileID: "abc123"}) {
+		c.NoErr(err)
+		ids = append(ids, entry.ID)
+	}
+	c.Equal(ids, []string{"whatsapp.net", "apple.com"})
+
+	for entry, err := range client.Denylist.All(context.Background(), &ListDenylistRequest{ProfileID: "abc123"}) {
+		c.NoErr(err)
+		c.Equal(entry.ID, "whatsapp.net")
+		break
+	}
+}
+
+func TestListIteratorError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"errors":[{"code":"notFound"}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	count := 0
+	for entry, err := range client.Rewrites.All(context.Background(), &ListRewritesRequest{ProfileID: "abc123"}) {
+		count++
+		c.Equal(entry, nil)
+		c.True(err != nil)
+	}
+	c.Equal(count, 1)
+}
+
+func TestPageIterator(t *testing.T) {
+	c := is.New(t)
+
+	var requests int32
+	profiles := newProfilesServer(t, 5)
+	defer profiles.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		profiles.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var ids []string
+	for p, err := range client.Profiles.All(context.Background(), &ListProfileRequest{Limit: 2}) {
+		c.NoErr(err)
+		ids = append(ids, p.ID)
+	}
+	c.Equal(ids, []string{"p0", "p1", "p2", "p3", "p4"})
+	c.Equal(atomic.LoadInt32(&requests), int32(3))
+
+	// Pages are only fetched when their items are consumed.
+	atomic.StoreInt32(&requests, 0)
+	for p, err := range client.Profiles.All(context.Background(), &ListProfileRequest{Limit: 2}) {
+		c.NoErr(err)
+		if p.ID == "p1" {
+			break
+		}
+	}
+	c.Equal(atomic.LoadInt32(&requests), int32(1))
+}
+
+func TestPageIteratorRangeTwice(t *testing.T) {
+	c := is.New(t)
+
+	ts := newProfilesServer(t, 3)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	seq := client.Profiles.All(context.Background(), &ListProfileRequest{Limit: 2})
+
+	// Each range starts again from the first page.
+	for range 2 {
+		var ids []string
+		for p, err := range seq {
+			c.NoErr(err)
+			ids = append(ids, p.ID)
+		}
+		c.Equal(ids, []string{"p0", "p1", "p2"})
+	}
+}
+
+func TestPageIteratorCursorCycle(t *testing.T) {
+	c := is.New(t)
+
+	// The API returns the cursors a, b, a, ... so the pages would be fetched forever.
+	cursors := map[string]string{"": "a", "a": "b", "b": "a"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		_, err := w.Write([]byte(`{"data":[{"id":"p` + cursor + `"}],"meta":{"pagination":{"cursor":"` + cursors[cursor] + `"}}}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var ids []string
+	for p, err := range client.Profiles.All(context.Background(), nil) {
+		c.NoErr(err)
+		ids = append(ids, p.ID)
+	}
+	c.Equal(ids, []string{"p", "pa", "pb"})
+}
+
+func TestPageIteratorOperation(t *testing.T) {
+	c := is.New(t)
+
+	ts := newProfilesServer(t, 5)
+	defer ts.Close()
+
+	events := []string{}
+	client, err := New(
+		WithBaseURL(ts.URL),
+		WithOperationHook(func(ctx context.Context, op *Operation) (context.Context, func(error)) {
+			events = append(events, "start:"+op.Name())
+			return ctx, func(err error) {
+				events = append(events, "end:"+op.Name())
+			}
+		}),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				events = append(events, call.Request.Method+":"+call.Operation.Name())
+				return next(ctx, call)
+			}
+		}),
+	)
+	c.NoErr(err)
+
+	// A range over the pages is a single operation, whether it is made by All or ListAll.
+	for _, err := range client.Profiles.All(context.Background(), &ListProfileRequest{Limit: 3}) {
+		c.NoErr(err)
+	}
+
+	_, err = client.Profiles.ListAll(context.Background(), &ListProfileRequest{Limit: 3})
+	c.NoErr(err)
+
+	c.Equal(events, []string{
+		"start:Profiles.All",
+		"GET:Profiles.All",
+		"GET:Profiles.All",
+		"end:Profiles.All",
+		"start:Profiles.ListAll",
+		"GET:Profiles.ListAll",
+		"GET:Profiles.ListAll",
+		"end:Profiles.ListAll",
+	})
+}
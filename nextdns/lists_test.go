@@ -0,0 +1,126 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestListItemsAddDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		add    func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error
+		delete func(ctx context.Context, client *Client, id string) error
+	}{
+		{
+			name: "ParentalControlServices",
+			path: "/profiles/abc123/parentalControl/services",
+			add: func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error {
+				return client.ParentalControlServices.Add(ctx, &AddParentalControlServicesRequest{
+					ProfileID:               "abc123",
+					ParentalControlServices: &ParentalControlServices{ID: id, Active: true},
+					IgnoreDuplicates:        ignoreDuplicates,
+				})
+			},
+			delete: func(ctx context.Context, client *Client, id string) error {
+				return client.ParentalControlServices.Delete(ctx, &DeleteParentalControlServicesRequest{ProfileID: "abc123", ID: id})
+			},
+		},
+		{
+			name: "ParentalControlCategories",
+			path: "/profiles/abc123/parentalControl/categories",
+			add: func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error {
+				return client.ParentalControlCategories.Add(ctx, &AddParentalControlCategoriesRequest{
+					ProfileID:                 "abc123",
+					ParentalControlCategories: &ParentalControlCategories{ID: id, Active: true},
+					IgnoreDuplicates:          ignoreDuplicates,
+				})
+			},
+			delete: func(ctx context.Context, client *Client, id string) error {
+				return client.ParentalControlCategories.Delete(ctx, &DeleteParentalControlCategoriesRequest{ProfileID: "abc123", ID: id})
+			},
+		},
+		{
+			name: "PrivacyBlocklists",
+			path: "/profiles/abc123/privacy/blocklists",
+			add: func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error {
+				return client.PrivacyBlocklists.Add(ctx, &AddPrivacyBlocklistsRequest{
+					ProfileID:         "abc123",
+					PrivacyBlocklists: &PrivacyBlocklists{ID: id},
+					IgnoreDuplicates:  ignoreDuplicates,
+				})
+			},
+			delete: func(ctx context.Context, client *Client, id string) error {
+				return client.PrivacyBlocklists.Delete(ctx, &DeletePrivacyBlocklistsRequest{ProfileID: "abc123", ID: id})
+			},
+		},
+		{
+			name: "PrivacyNatives",
+			path: "/profiles/abc123/privacy/natives",
+			add: func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error {
+				return client.PrivacyNatives.Add(ctx, &AddPrivacyNativesRequest{
+					ProfileID:        "abc123",
+					PrivacyNatives:   &PrivacyNatives{ID: id},
+					IgnoreDuplicates: ignoreDuplicates,
+				})
+			},
+			delete: func(ctx context.Context, client *Client, id string) error {
+				return client.PrivacyNatives.Delete(ctx, &DeletePrivacyNativesRequest{ProfileID: "abc123", ID: id})
+			},
+		},
+		{
+			name: "SecurityTlds",
+			path: "/profiles/abc123/security/tlds",
+			add: func(ctx context.Context, client *Client, id string, ignoreDuplicates bool) error {
+				return client.SecurityTlds.Add(ctx, &AddSecurityTldsRequest{
+					ProfileID:        "abc123",
+					SecurityTlds:     &SecurityTlds{ID: id},
+					IgnoreDuplicates: ignoreDuplicates,
+				})
+			},
+			delete: func(ctx context.Context, client *Client, id string) error {
+				return client.SecurityTlds.Delete(ctx, &DeleteSecurityTldsRequest{ProfileID: "abc123", ID: id})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := is.New(t)
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					c.Equal(r.URL.Path, tt.path)
+					// The duplicates are reported with a 200 error envelope.
+					_, err := w.Write([]byte(`{"errors":[{"code":"duplicate","source":{"parameter":"id"}}]}`))
+					c.NoErr(err)
+				case http.MethodDelete:
+					c.Equal(r.URL.EscapedPath(), tt.path+"/a%2Fb")
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer ts.Close()
+
+			client, err := New(WithBaseURL(ts.URL))
+			c.NoErr(err)
+
+			ctx := context.Background()
+
+			err = tt.add(ctx, client, "a", false)
+			c.True(errors.Is(err, ErrDuplicate))
+
+			err = tt.add(ctx, client, "a", true)
+			c.NoErr(err)
+
+			// The ID is escaped, so it stays a single segment of the path.
+			err = tt.delete(ctx, client, "a/b")
+			c.NoErr(err)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package nextdns
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// WithLogger sets the structured logger used to emit the request and response events.
+// Events are emitted at the debug level, and only include the bodies if WithLogBodies is also set.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogBodies includes the request and response bodies in the logged events.
+func WithLogBodies() ClientOption {
+	return func(c *Client) error {
+		c.logBodies = true
+		return nil
+	}
+}
+
+// debugLogger returns the logger used in debug mode when no logger was set, created on first use.
+var debugLogger = sync.OnceValue(func() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+})
+
+// logRequest emits the event for a request about to be sent.
+func (c *Client) logRequest(ctx context.Context, req *http.Request) {
+	logger := c.eventLogger(ctx)
+	if logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("profile_id", c.profileIDFromURL(req.URL)),
+		slog.Int64("body_size", req.ContentLength),
+	}
+
+	if c.logBodiesEnabled() && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			out, err := io.ReadAll(body)
+			if err == nil && len(out) > 0 {
+				attrs = append(attrs, slog.String("body", c.redactor.body(bytes.TrimSuffix(out, []byte("\n")))))
+			}
+		}
+	}
+
+	if c.logBodiesEnabled() {
+		attrs = append(attrs, slog.Any("headers", c.redactor.header(req.Header)))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "nextdns request", attrs...)
+}
+
+// logResponse emits the event for a response received from the NextDNS API.
+func (c *Client) logResponse(ctx context.Context, res *http.Response, body []byte, duration time.Duration) {
+	logger := c.eventLogger(ctx)
+	if logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", res.Request.Method),
+		slog.String("path", res.Request.URL.Path),
+		slog.String("profile_id", c.profileIDFromURL(res.Request.URL)),
+		slog.Int("status", res.StatusCode),
+		slog.Duration("duration", duration),
+		slog.Int("body_size", len(body)),
+	}
+
+	if c.logBodiesEnabled() && len(body) > 0 {
+		attrs = append(attrs, slog.String("body", c.redactor.body(body)))
+	}
+
+	if c.logBodiesEnabled() {
+		attrs = append(attrs, slog.Any("headers", c.redactor.header(res.Header)))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "nextdns response", attrs...)
+}
+
+// logFailure emits the event for a request that didn't get a response from the NextDNS API.
+func (c *Client) logFailure(ctx context.Context, req *http.Request, duration time.Duration, err error) {
+	logger := c.eventLogger(ctx)
+	if logger == nil {
+		return
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "nextdns request failed",
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("profile_id", c.profileIDFromURL(req.URL)),
+		slog.Duration("duration", duration),
+		slog.String("error", err.Error()),
+	)
+}
+
+// eventLogger returns the logger of the events, or nil when they are not logged.
+// The debug mode is read on each event, as the Debug field can be set once the client is created.
+func (c *Client) eventLogger(ctx context.Context) *slog.Logger {
+	logger := c.logger
+	if logger == nil && c.Debug {
+		logger = debugLogger()
+	}
+
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return nil
+	}
+
+	return logger
+}
+
+// logBodiesEnabled reports whether the bodies and headers are included in the events, as in debug mode.
+func (c *Client) logBodiesEnabled() bool {
+	return c.logBodies || c.Debug
+}
@@ -0,0 +1,117 @@
+package nextdns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func testLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func decodeLogEvents(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var events []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		event := map[string]interface{}{}
+		err := json.Unmarshal([]byte(line), &event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestLogger(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":[{"id":"apple.com","active":true}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	client, err := New(WithBaseURL(ts.URL), WithLogger(testLogger(buf)))
+	c.NoErr(err)
+
+	_, err = client.Denylist.List(context.Background(), &ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	events := decodeLogEvents(t, buf)
+	c.Equal(len(events), 2)
+
+	c.Equal(events[0]["msg"], "nextdns request")
+	c.Equal(events[0]["method"], http.MethodGet)
+	c.Equal(events[0]["path"], "/profiles/abc123/denylist")
+	c.Equal(events[0]["profile_id"], "abc123")
+
+	c.Equal(events[1]["msg"], "nextdns response")
+	c.Equal(events[1]["status"], float64(http.StatusOK))
+	c.Equal(events[1]["body_size"], float64(43))
+	c.True(events[1]["duration"] != nil)
+	c.Equal(events[1]["body"], nil)
+}
+
+func TestLoggerBodies(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	client, err := New(WithBaseURL(ts.URL), WithLogger(testLogger(buf)), WithLogBodies())
+	c.NoErr(err)
+
+	err = client.Denylist.Update(context.Background(), &UpdateDenylistRequest{
+		ProfileID: "abc123",
+		ID:        "apple.com",
+		Denylist:  &Denylist{Active: true},
+	})
+	c.NoErr(err)
+
+	events := decodeLogEvents(t, buf)
+	c.Equal(len(events), 2)
+	c.Equal(events[0]["body"], `{"active":true}`)
+	c.Equal(events[1]["status"], float64(http.StatusNoContent))
+}
+
+func TestLoggerDebugAfterNew(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	client, err := New(WithBaseURL(ts.URL), WithLogger(testLogger(buf)))
+	c.NoErr(err)
+
+	// The debug mode is read on each request, so it can be enabled once the client is created.
+	client.Debug = true
+
+	err = client.Denylist.Update(context.Background(), &UpdateDenylistRequest{
+		ProfileID: "abc123",
+		ID:        "apple.com",
+		Denylist:  &Denylist{Active: true},
+	})
+	c.NoErr(err)
+
+	events := decodeLogEvents(t, buf)
+	c.Equal(len(events), 2)
+	c.Equal(events[0]["body"], `{"active":true}`)
+}
@@ -0,0 +1,163 @@
+package nextdns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// logsAPIPath is the HTTP path for the logs API.
+const logsAPIPath = "logs"
+
+// LogEntry represents a DNS query of the logs.
+type LogEntry struct {
+	// ID is the ID of the entry in the logs stream, used to resume the stream. It is empty for the listed logs.
+	ID string `json:"-"`
+
+	Timestamp time.Time    `json:"timestamp"`
+	Domain    string       `json:"domain"`
+	Root      string       `json:"root,omitempty"`
+	Tracker   string       `json:"tracker,omitempty"`
+	Encrypted bool         `json:"encrypted"`
+	Protocol  string       `json:"protocol"`
+	ClientIP  string       `json:"clientIp"`
+	Client    string       `json:"client,omitempty"`
+	Device    *LogDevice   `json:"device,omitempty"`
+	Status    string       `json:"status"`
+	Reasons   []*LogReason `json:"reasons,omitempty"`
+}
+
+// LogDevice represents the device that made a DNS query.
+type LogDevice struct {
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Model   string `json:"model,omitempty"`
+	LocalIP string `json:"localIp,omitempty"`
+}
+
+// LogReason represents the reason why a DNS query was blocked or allowed (e.g. a blocklist).
+type LogReason struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// LogsFilter encapsulates the filters shared by the logs requests.
+type LogsFilter struct {
+	// Device restricts the logs to a device ID.
+	Device string `url:"device,omitempty"`
+
+	// Status restricts the logs to a resolution status ("default", "blocked", "allowed" or "error").
+	Status string `url:"status,omitempty"`
+
+	// Search restricts the logs to the domains containing the search.
+	Search string `url:"search,omitempty"`
+
+	// Raw returns all the queries, including the ones filtered out by default (e.g. the duplicates).
+	Raw bool `url:"raw,omitempty"`
+}
+
+// ListLogsRequest encapsulates the request for listing the logs.
+type ListLogsRequest struct {
+	ProfileID string
+
+	// TimeRange restricts the logs to a time range, the API defaults are used when it is unset.
+	TimeRange
+	LogsFilter
+
+	// Sort sorts the logs by timestamp, "asc" or "desc" (the API default).
+	Sort string `url:"sort,omitempty"`
+
+	// Limit is the maximum number of logs per page, or zero to use the API default.
+	Limit int `url:"limit,omitempty"`
+
+	// Cursor is the cursor of the page to list, as returned by the previous page.
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// LogsPage represents a page of logs.
+type LogsPage struct {
+	Logs []*LogEntry
+
+	// Cursor is the cursor of the next page, or empty if there is no next page.
+	Cursor string
+}
+
+// LogsService is an interface for communicating with the NextDNS logs API endpoint.
+type LogsService interface {
+	List(context.Context, *ListLogsRequest) (*LogsPage, error)
+	All(context.Context, *ListLogsRequest) iter.Seq2[*LogEntry, error]
+	Stream(context.Context, *StreamLogsRequest) (*LogStream, error)
+	Download(context.Context, *DownloadLogsRequest) (io.ReadCloser, error)
+	Clear(context.Context, *ClearLogsRequest) error
+}
+
+// logsResponse represents the logs response.
+type logsResponse struct {
+	Logs     []*LogEntry `json:"data"`
+	Metadata struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// logsService represents the NextDNS logs service.
+type logsService struct {
+	client *Client
+}
+
+var _ LogsService = &logsService{}
+
+// NewLogsService returns a new NextDNS logs service.
+// nolint: revive
+func NewLogsService(client *Client) *logsService {
+	return &logsService{
+		client: client,
+	}
+}
+
+// List returns a page of logs, the most recent first unless sorted otherwise.
+func (s *logsService) List(ctx context.Context, request *ListLogsRequest) (*LogsPage, error) {
+	ctx = withOperation(ctx, "Logs", "List", request.ProfileID)
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("error creating request to list the logs: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), logsAPIPath)
+	req, err := s.client.newRequest(http.MethodGet, path, request, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to list the logs: %w", err)
+	}
+
+	response := logsResponse{}
+	err = s.client.do(ctx, req, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error making a request to list the logs: %w", err)
+	}
+
+	return &LogsPage{
+		Logs:   response.Logs,
+		Cursor: response.Metadata.Pagination.Cursor,
+	}, nil
+}
+
+// All returns an iterator over the logs, fetching the pages as the logs are consumed.
+func (s *logsService) All(ctx context.Context, request *ListLogsRequest) iter.Seq2[*LogEntry, error] {
+	first := ListLogsRequest{}
+	if request != nil {
+		first = *request
+	}
+	ctx = withOperation(ctx, "Logs", "All", first.ProfileID)
+
+	return pageSeq(ctx, s.client, first.Cursor, func(ctx context.Context, cursor string) ([]*LogEntry, string, error) {
+		next := first
+		next.Cursor = cursor
+		page, err := s.List(ctx, &next)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Logs, page.Cursor, nil
+	})
+}
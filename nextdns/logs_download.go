@@ -0,0 +1,87 @@
+package nextdns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// logsDownloadAPIPath is the HTTP path for the logs download API.
+const logsDownloadAPIPath = "logs/download"
+
+// DownloadLogsRequest encapsulates the request for downloading the logs.
+type DownloadLogsRequest struct {
+	ProfileID string
+
+	// Progress is called as the download is read, with the number of bytes read so far and the total size
+	// of the download, or -1 when the API doesn't return it.
+	Progress func(read int64, total int64)
+}
+
+// ClearLogsRequest encapsulates the request for clearing the logs.
+type ClearLogsRequest struct {
+	ProfileID string
+}
+
+// Download returns the logs of the profile as a CSV file. The download is streamed from the API as it is read,
+// and the caller must close it.
+func (s *logsService) Download(ctx context.Context, request *DownloadLogsRequest) (io.ReadCloser, error) {
+	ctx = withOperation(ctx, "Logs", "Download", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), logsDownloadAPIPath)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to download the logs: %w", err)
+	}
+
+	res, err := s.client.stream(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error making a request to download the logs: %w", err)
+	}
+
+	if request.Progress == nil {
+		return res.Body, nil
+	}
+
+	return &progressReader{
+		ReadCloser: res.Body,
+		total:      res.ContentLength,
+		progress:   request.Progress,
+	}, nil
+}
+
+// Clear deletes all the logs of the profile.
+func (s *logsService) Clear(ctx context.Context, request *ClearLogsRequest) error {
+	ctx = withOperation(ctx, "Logs", "Clear", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), logsAPIPath)
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to clear the logs: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to clear the logs: %w", err)
+	}
+
+	return nil
+}
+
+// progressReader represents a reader that reports the progress of the reads.
+type progressReader struct {
+	io.ReadCloser
+	read     int64
+	total    int64
+	progress func(read int64, total int64)
+}
+
+// Read reads from the underlying reader and reports the progress.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+
+	return n, err
+}
@@ -0,0 +1,90 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestLogsDownload(t *testing.T) {
+	c := is.New(t)
+
+	csv := "timestamp,domain,status\n" + strings.Repeat("2023-01-01T10:00:00Z,apple.com,default\n", 1000)
+
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The API key is only sent to the API.
+		c.Equal(r.Header.Get("X-Api-Key"), "")
+		c.Equal(r.URL.Path, "/logs.csv")
+
+		_, err := w.Write([]byte(csv))
+		c.NoErr(err)
+	}))
+	defer files.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Header.Get("X-Api-Key"), "secret")
+		c.Equal(r.URL.Path, "/profiles/abc123/logs/download")
+
+		http.Redirect(w, r, files.URL+"/logs.csv", http.StatusFound)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithAPIKey("secret"))
+	c.NoErr(err)
+
+	var read, total int64
+	body, err := client.Logs.Download(context.Background(), &DownloadLogsRequest{
+		ProfileID: "abc123",
+		Progress: func(r int64, t int64) {
+			read, total = r, t
+		},
+	})
+	c.NoErr(err)
+	defer body.Close()
+
+	out, err := io.ReadAll(body)
+	c.NoErr(err)
+	c.Equal(string(out), csv)
+	c.Equal(read, int64(len(csv)))
+	c.True(total == int64(len(csv)) || total == -1)
+}
+
+func TestLogsDownloadError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := w.Write([]byte(`{"errors":[{"code":"unauthorized"}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	_, err = client.Logs.Download(context.Background(), &DownloadLogsRequest{ProfileID: "abc123"})
+	c.True(errors.Is(err, ErrUnauthorized))
+}
+
+func TestLogsClear(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Method, http.MethodDelete)
+		c.Equal(r.URL.Path, "/profiles/abc123/logs")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Logs.Clear(context.Background(), &ClearLogsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+}
@@ -0,0 +1,245 @@
+package nextdns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// logsStreamAPIPath is the HTTP path for the logs stream API.
+	logsStreamAPIPath = "logs/stream"
+
+	// defaultLogStreamBuffer is the default number of entries buffered by a logs stream.
+	defaultLogStreamBuffer = 100
+
+	// defaultLogStreamRetry is the delay before reconnecting a logs stream, unless the API asks for another one.
+	defaultLogStreamRetry = time.Second
+
+	// maxLogStreamRetry is the maximum delay between the reconnections of a logs stream while they fail.
+	maxLogStreamRetry = time.Minute
+)
+
+// StreamLogsRequest encapsulates the request for streaming the logs in real time.
+type StreamLogsRequest struct {
+	ProfileID string
+	LogsFilter
+
+	// LastEventID resumes the stream after the entry with this ID, such as the ID of the last entry processed.
+	LastEventID string `url:"id,omitempty"`
+
+	// Buffer is the number of entries buffered while the consumer is busy, or zero to use the default of 100.
+	// When the buffer is full, the stream stops reading from the API until the consumer catches up.
+	Buffer int `url:"-"`
+}
+
+// LogStream represents a stream of the logs in real time. The stream reconnects automatically when the
+// connection is lost, resuming after the last entry received, until it is closed or its context is canceled.
+// The failed reconnections are retried with an exponential backoff, starting from the retry delay of the API,
+// except for the errors that won't go away (i.e. a 401, 403 or 404 response), which end the stream.
+type LogStream struct {
+	entries chan *LogEntry
+	cancel  context.CancelFunc
+	done    chan struct{}
+	closed  atomic.Bool
+	err     error
+}
+
+// Entries returns the channel of the entries of the stream, closed when the stream ends.
+func (s *LogStream) Entries() <-chan *LogEntry {
+	return s.entries
+}
+
+// Err returns the error that ended the stream, once the entries channel is closed.
+// It returns nil when the stream was closed with Close, and the context error when its context was canceled.
+func (s *LogStream) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close stops the stream and waits for its connection to be closed.
+func (s *LogStream) Close() error {
+	s.closed.Store(true)
+	s.cancel()
+	<-s.done
+
+	return nil
+}
+
+// end records the error that ended the stream, the context error taking precedence unless the stream was closed.
+func (s *LogStream) end(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		if s.closed.Load() {
+			return
+		}
+		err = ctx.Err()
+	}
+
+	s.err = err
+}
+
+// Stream opens a stream of the logs in real time. The first connection is made before returning, so the
+// errors of the request (e.g. an unknown profile) are returned by Stream instead of ending the stream.
+func (s *logsService) Stream(ctx context.Context, request *StreamLogsRequest) (*LogStream, error) {
+	ctx = withOperation(ctx, "Logs", "Stream", request.ProfileID)
+	ctx, cancel := context.WithCancel(ctx)
+
+	res, err := s.openStream(ctx, request, request.LastEventID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	buffer := request.Buffer
+	if buffer <= 0 {
+		buffer = defaultLogStreamBuffer
+	}
+
+	stream := &LogStream{
+		entries: make(chan *LogEntry, buffer),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go s.runStream(ctx, stream, request, res)
+
+	return stream, nil
+}
+
+// openStream opens a connection to the logs stream, resuming after the last event ID if any.
+func (s *logsService) openStream(ctx context.Context, request *StreamLogsRequest, lastEventID string) (*http.Response, error) {
+	query := *request
+	query.LastEventID = lastEventID
+
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), logsStreamAPIPath)
+	req, err := s.client.newRequest(http.MethodGet, path, &query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to stream the logs: %w", err)
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := s.client.stream(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error making a request to stream the logs: %w", err)
+	}
+
+	return res, nil
+}
+
+// runStream reads the entries of the stream until it ends, reconnecting when the connection is lost.
+func (s *logsService) runStream(ctx context.Context, stream *LogStream, request *StreamLogsRequest, res *http.Response) {
+	defer close(stream.done)
+	defer close(stream.entries)
+
+	lastEventID := request.LastEventID
+	retry := defaultLogStreamRetry
+
+	for {
+		err := readLogStream(ctx, stream, res, &lastEventID, &retry)
+		res.Body.Close()
+
+		// The entries that can't be decoded end the stream, as reconnecting would fail the same way.
+		if err != nil || ctx.Err() != nil {
+			stream.end(ctx, err)
+			return
+		}
+
+		res, err = s.reconnect(ctx, request, lastEventID, retry)
+		if err != nil {
+			stream.end(ctx, err)
+			return
+		}
+	}
+}
+
+// reconnect opens the stream again after the retry delay, doubling the delay after each failed attempt up to
+// maxLogStreamRetry, until it succeeds, the context is done or the error is permanent.
+func (s *logsService) reconnect(ctx context.Context, request *StreamLogsRequest, lastEventID string, retry time.Duration) (*http.Response, error) {
+	delay := retry
+
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		res, err := s.openStream(ctx, request, lastEventID)
+		if err == nil || ctx.Err() != nil || permanentStreamError(err) {
+			return res, err
+		}
+
+		if delay < maxLogStreamRetry {
+			delay = min(2*delay, maxLogStreamRetry)
+		}
+	}
+}
+
+// permanentStreamError reports whether the error of a reconnection won't go away by retrying, as the API key
+// is rejected or the profile doesn't exist anymore.
+func permanentStreamError(err error) bool {
+	var clientErr *Error
+	if !errors.As(err, &clientErr) {
+		return false
+	}
+
+	switch clientErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+// readLogStream sends the entries of a connection to the stream until the connection ends.
+// It only returns an error when an entry can't be decoded, the connection errors being handled by reconnecting.
+func readLogStream(ctx context.Context, stream *LogStream, res *http.Response, lastEventID *string, retry *time.Duration) error {
+	events := newServerEventReader(res)
+
+	for {
+		event, err := events.next()
+		if err != nil {
+			return nil
+		}
+
+		if event.Retry > 0 {
+			*retry = event.Retry
+		}
+		if event.Data == "" {
+			if event.ID != "" {
+				*lastEventID = event.ID
+			}
+			continue
+		}
+
+		entry := &LogEntry{}
+		if err := json.Unmarshal([]byte(event.Data), entry); err != nil {
+			return fmt.Errorf("error decoding the logs stream entry %q: %w", event.ID, err)
+		}
+		entry.ID = event.ID
+
+		select {
+		case stream.entries <- entry:
+		case <-ctx.Done():
+			return nil
+		}
+
+		// The ID is only recorded once the entry is buffered, so a reconnection never skips it.
+		if event.ID != "" {
+			*lastEventID = event.ID
+		}
+	}
+}
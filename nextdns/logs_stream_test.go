@@ -0,0 +1,231 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+// newLogStreamServer returns a test server streaming the logs, closing the connection after each batch of events.
+func newLogStreamServer(t *testing.T, batches ...string) (*httptest.Server, *[]string) {
+	t.Helper()
+	c := is.New(t)
+
+	var connections int32
+	lastEventIDs := []string{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/logs/stream")
+		c.Equal(r.Header.Get("Accept"), "text/event-stream")
+
+		n := int(atomic.AddInt32(&connections, 1)) - 1
+		lastEventIDs = append(lastEventIDs, r.URL.Query().Get("id"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+
+		if n < len(batches) {
+			_, err := fmt.Fprint(w, batches[n])
+			c.NoErr(err)
+			w.(http.Flusher).Flush()
+			return
+		}
+
+		// Keeps the last connection open until the client closes it.
+		<-r.Context().Done()
+	})), &lastEventIDs
+}
+
+func TestLogsStreamReconnect(t *testing.T) {
+	c := is.New(t)
+
+	ts, lastEventIDs := newLogStreamServer(t,
+		"retry: 10\n: keep-alive\n\nid: 1\ndata: {\"domain\":\"a.com\",\"status\":\"default\"}\n\nid: 2\ndata: {\"domain\":\"b.com\"}\n\n",
+		"id: 3\ndata: {\"domain\":\"c.com\",\n",
+		"id: 3\ndata: {\"domain\":\"c.com\"}\n\n",
+	)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	stream, err := client.Logs.Stream(context.Background(), &StreamLogsRequest{
+		ProfileID:  "abc123",
+		LogsFilter: LogsFilter{Status: "blocked"},
+	})
+	c.NoErr(err)
+
+	var entries []*LogEntry
+	for entry := range stream.Entries() {
+		entries = append(entries, entry)
+		if len(entries) == 3 {
+			break
+		}
+	}
+	c.NoErr(stream.Close())
+	c.NoErr(stream.Err())
+
+	c.Equal(len(entries), 3)
+	c.Equal(entries[0].ID, "1")
+	c.Equal(entries[0].Domain, "a.com")
+	c.Equal(entries[2].ID, "3")
+	c.Equal(entries[2].Domain, "c.com")
+
+	// The incomplete event of the second connection is dropped, and resumed from the last entry received.
+	c.Equal((*lastEventIDs)[:3], []string{"", "2", "2"})
+}
+
+func TestLogsStreamCancel(t *testing.T) {
+	c := is.New(t)
+
+	ts, _ := newLogStreamServer(t)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.Logs.Stream(ctx, &StreamLogsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	cancel()
+	select {
+	case _, ok := <-stream.Entries():
+		c.True(!ok)
+	case <-time.After(time.Second):
+		t.Fatal("the stream wasn't closed on context cancel")
+	}
+	c.True(errors.Is(stream.Err(), context.Canceled))
+}
+
+func TestLogsStreamBackpressure(t *testing.T) {
+	c := is.New(t)
+
+	events := ""
+	for i := 0; i < 10; i++ {
+		events += fmt.Sprintf("id: %d\ndata: {\"domain\":\"%d.com\"}\n\n", i, i)
+	}
+
+	ts, _ := newLogStreamServer(t, events)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	stream, err := client.Logs.Stream(context.Background(), &StreamLogsRequest{ProfileID: "abc123", Buffer: 2})
+	c.NoErr(err)
+
+	// The stream stops reading when the buffer is full, and doesn't lose the entries.
+	time.Sleep(50 * time.Millisecond)
+	c.Equal(len(stream.Entries()), 2)
+
+	for i := 0; i < 10; i++ {
+		entry := <-stream.Entries()
+		c.Equal(entry.ID, fmt.Sprint(i))
+	}
+	c.NoErr(stream.Close())
+}
+
+func TestLogsStreamError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"errors":[{"code":"notFound"}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	_, err = client.Logs.Stream(context.Background(), &StreamLogsRequest{ProfileID: "abc123"})
+	c.True(errors.Is(err, ErrNotFound))
+}
+
+func TestLogsStreamReconnectBackoff(t *testing.T) {
+	c := is.New(t)
+
+	var connections int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&connections, 1)
+
+		// The reconnections fail twice before the API is back.
+		if n == 2 || n == 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, "retry: 5\nid: %d\ndata: {\"domain\":\"%d.com\"}\n\n", n, n)
+		c.NoErr(err)
+		w.(http.Flusher).Flush()
+
+		if n > 1 {
+			<-r.Context().Done()
+		}
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	stream, err := client.Logs.Stream(context.Background(), &StreamLogsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	first := <-stream.Entries()
+	c.Equal(first.ID, "1")
+
+	select {
+	case entry := <-stream.Entries():
+		c.Equal(entry.ID, "4")
+	case <-time.After(time.Second):
+		t.Fatal("the stream didn't reconnect after the failed attempts")
+	}
+
+	c.NoErr(stream.Close())
+	c.NoErr(stream.Err())
+}
+
+func TestLogsStreamReconnectPermanentError(t *testing.T) {
+	c := is.New(t)
+
+	var connections int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&connections, 1) > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprint(w, "retry: 5\nid: 1\ndata: {\"domain\":\"a.com\"}\n\n")
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	stream, err := client.Logs.Stream(context.Background(), &StreamLogsRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	count := 0
+	for range stream.Entries() {
+		count++
+	}
+
+	// The rejected API key ends the stream instead of being retried.
+	c.Equal(count, 1)
+	c.True(errors.Is(stream.Err(), ErrUnauthorized))
+	c.Equal(atomic.LoadInt32(&connections), int32(2))
+}
@@ -0,0 +1,124 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestLogsList(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles/abc123/logs")
+		c.Equal(r.URL.Query().Get("from"), "-1d")
+		c.Equal(r.URL.Query().Get("status"), "blocked")
+		c.Equal(r.URL.Query().Get("search"), "apple")
+		c.Equal(r.URL.Query().Get("raw"), "true")
+		c.Equal(r.URL.Query().Get("sort"), "asc")
+		c.Equal(r.URL.Query().Get("limit"), "10")
+
+		_, err := w.Write([]byte(`{
+			"data":[{
+				"timestamp":"2023-01-01T10:00:00.123Z",
+				"domain":"metrics.icloud.com",
+				"root":"icloud.com",
+				"tracker":"apple",
+				"encrypted":true,
+				"protocol":"DNS-over-HTTPS",
+				"clientIp":"91.171.12.34",
+				"device":{"id":"8TD1G","name":"iPhone","model":"iPhone 12 Pro Max"},
+				"status":"blocked",
+				"reasons":[{"id":"blocklist:nextdns-recommended","name":"NextDNS Ads & Trackers Blocklist"}]
+			}],
+			"meta":{"pagination":{"cursor":"next"}}
+		}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	page, err := client.Logs.List(context.Background(), &ListLogsRequest{
+		ProfileID:  "abc123",
+		TimeRange:  Last(24 * time.Hour),
+		LogsFilter: LogsFilter{Status: "blocked", Search: "apple", Raw: true},
+		Sort:       "asc",
+		Limit:      10,
+	})
+	c.NoErr(err)
+	c.Equal(page.Cursor, "next")
+	c.Equal(page.Logs, []*LogEntry{{
+		Timestamp: time.Date(2023, 1, 1, 10, 0, 0, 123000000, time.UTC),
+		Domain:    "metrics.icloud.com",
+		Root:      "icloud.com",
+		Tracker:   "apple",
+		Encrypted: true,
+		Protocol:  "DNS-over-HTTPS",
+		ClientIP:  "91.171.12.34",
+		Device:    &LogDevice{ID: "8TD1G", Name: "iPhone", Model: "iPhone 12 Pro Max"},
+		Status:    "blocked",
+		Reasons:   []*LogReason{{ID: "blocklist:nextdns-recommended", Name: "NextDNS Ads & Trackers Blocklist"}},
+	}})
+}
+
+func TestLogsAll(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Query().Get("device"), "8TD1G")
+
+		var err error
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, err = fmt.Fprint(w, `{"data":[{"domain":"a.com"},{"domain":"b.com"}],"meta":{"pagination":{"cursor":"2"}}}`)
+		case "2":
+			_, err = fmt.Fprint(w, `{"data":[{"domain":"c.com"}],"meta":{"pagination":{"cursor":null}}}`)
+		}
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var domains []string
+	for entry, err := range client.Logs.All(context.Background(), &ListLogsRequest{
+		ProfileID:  "abc123",
+		LogsFilter: LogsFilter{Device: "8TD1G"},
+	}) {
+		c.NoErr(err)
+		domains = append(domains, entry.Domain)
+	}
+	c.Equal(domains, []string{"a.com", "b.com", "c.com"})
+}
+
+func TestLogsAllNilRequest(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := fmt.Fprint(w, `{"errors":[{"code":"notFound"}]}`)
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	// A nil request is sent as an empty one, so the API error is returned instead of panicking.
+	count := 0
+	for entry, err := range client.Logs.All(context.Background(), nil) {
+		count++
+		c.Equal(entry, nil)
+		c.True(errors.Is(err, ErrNotFound))
+	}
+	c.Equal(count, 1)
+}
@@ -0,0 +1,130 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// listMerge represents the per-item operations of a list, used to merge changes into its current items
+// with the minimal set of calls instead of replacing the whole list. The add and remove operations must
+// ignore the duplicate and not found errors, as the list can be changed by others once it is listed.
+type listMerge[T any] struct {
+	list   func(ctx context.Context) ([]*T, error)
+	add    func(ctx context.Context, item *T) error
+	update func(ctx context.Context, item *T) error
+	remove func(ctx context.Context, id string) error
+
+	// id returns the ID of an item, and equal reports whether two items with the same ID have the same settings.
+	// The equal function is nil for the lists whose items have no settings besides their ID.
+	id    func(item *T) string
+	equal func(a *T, b *T) bool
+
+	// setActive returns a copy of the item with the active flag set, for the lists that have one.
+	setActive func(item *T, active bool) *T
+}
+
+// current returns the current items of the list by ID.
+func (m listMerge[T]) current(ctx context.Context) (map[string]*T, error) {
+	items, err := m.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]*T, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		current[m.id(item)] = item
+	}
+
+	return current, nil
+}
+
+// addEntries adds the missing items and updates the ones whose settings differ, leaving the other items unchanged.
+// The nil items are skipped.
+func (m listMerge[T]) addEntries(ctx context.Context, items []*T) error {
+	current, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		existing, ok := current[m.id(item)]
+		switch {
+		case !ok:
+			err = m.add(ctx, item)
+		case m.equal != nil && !m.equal(existing, item):
+			err = m.update(ctx, item)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeEntries deletes the items of the list with the given IDs, ignoring the IDs that are not in the list.
+func (m listMerge[T]) removeEntries(ctx context.Context, ids []string) error {
+	current, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if _, ok := current[id]; !ok {
+			continue
+		}
+
+		if err := m.remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setActiveEntries sets the active flag of the items with the given IDs, updating only the ones that differ.
+// Nothing is updated when some IDs are not in the list, and an error is returned for the lists without an active flag.
+func (m listMerge[T]) setActiveEntries(ctx context.Context, ids []string, active bool) error {
+	if m.setActive == nil || m.equal == nil {
+		return fmt.Errorf("%w: the list has no active flag", errors.ErrUnsupported)
+	}
+
+	current, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	missing := []string{}
+	for _, id := range ids {
+		if _, ok := current[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, strings.Join(missing, ", "))
+	}
+
+	for _, id := range ids {
+		item := m.setActive(current[id], active)
+		if m.equal(current[id], item) {
+			continue
+		}
+
+		if err := m.update(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestListMergeSetActiveUnsupported(t *testing.T) {
+	c := is.New(t)
+
+	listed := false
+	m := listMerge[PrivacyBlocklists]{
+		list: func(ctx context.Context) ([]*PrivacyBlocklists, error) {
+			listed = true
+			return []*PrivacyBlocklists{{ID: "nextdns-recommended"}}, nil
+		},
+		id: func(item *PrivacyBlocklists) string {
+			return item.ID
+		},
+	}
+
+	// The lists without an active flag fail before listing their items.
+	err := m.setActiveEntries(context.Background(), []string{"nextdns-recommended"}, true)
+	c.True(errors.Is(err, errors.ErrUnsupported))
+	c.True(!listed)
+}
@@ -0,0 +1,82 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+)
+
+// Call represents a call to the NextDNS API made by a service method.
+type Call struct {
+	// Operation is the logical operation of the call.
+	Operation *Operation
+
+	// Endpoint is the templated path of the call, without any profile or item ID (e.g. "profiles/:id/denylist").
+	// It is "other" for the requests that are not made to the NextDNS API.
+	Endpoint string
+
+	// Request is the HTTP request of the call.
+	Request *http.Request
+
+	// Result is the value the response body is decoded into, or nil if the response body is discarded.
+	Result interface{}
+
+	// StatusCode is the HTTP status code of the response, set once the call is executed.
+	// It is zero when the call didn't get a response.
+	StatusCode int
+}
+
+// Handler executes a call to the NextDNS API.
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps a Handler with additional behavior, like auditing, caching or policy checks.
+// A middleware can inspect and modify the call, and decide whether to call the next handler.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares around the calls made to the NextDNS API.
+// The middlewares run in the order they are given, the first one being the outermost.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range middlewares {
+			if m != nil {
+				c.middlewares = append(c.middlewares, m)
+			}
+		}
+		return nil
+	}
+}
+
+// Transport wraps the HTTP transport of the client. Unlike a Middleware, which sees a call once, a transport sees
+// each HTTP request sent for the call, including the retries of the retry policy and the redirects.
+type Transport func(next http.RoundTripper) http.RoundTripper
+
+// WithTransport adds transports around the HTTP requests made by the client. The transports run in the order
+// they are given, the first one being the outermost, and before the API key is added to the requests.
+// The call of a request can be read from its context with CallFromContext.
+func WithTransport(transports ...Transport) ClientOption {
+	return func(c *Client) error {
+		for _, t := range transports {
+			if t != nil {
+				c.transports = append(c.transports, t)
+			}
+		}
+		return nil
+	}
+}
+
+// callKey is the context key of the call whose requests are sent.
+type callKey struct{}
+
+// CallFromContext returns the call of the HTTP requests seen by the transports, or nil if there is none.
+func CallFromContext(ctx context.Context) *Call {
+	call, _ := ctx.Value(callKey{}).(*Call)
+	return call
+}
+
+// chainMiddlewares returns the handler wrapped by the middlewares of the client.
+func (c *Client) chainMiddlewares(handler Handler) Handler {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	return handler
+}
@@ -0,0 +1,197 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestMiddleware(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Header.Get("X-Audit"), "Denylist.Update")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				calls = append(calls, name+":"+call.Operation.Name()+":"+call.Operation.ProfileID)
+				call.Request.Header.Set("X-Audit", call.Operation.Name())
+				return next(ctx, call)
+			}
+		}
+	}
+
+	client, err := New(WithBaseURL(ts.URL), WithMiddleware(record("first"), record("second")))
+	c.NoErr(err)
+
+	err = client.Denylist.Update(context.Background(), &UpdateDenylistRequest{
+		ProfileID: "abc123",
+		ID:        "apple.com",
+		Denylist:  &Denylist{Active: true},
+	})
+	c.NoErr(err)
+	c.Equal(calls, []string{"first:Denylist.Update:abc123", "second:Denylist.Update:abc123"})
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request must not reach the server")
+	}))
+	defer ts.Close()
+
+	errDenied := errors.New("denied by policy")
+	policy := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			if call.Operation.Service == "Profiles" && call.Operation.Method == "Delete" {
+				return errDenied
+			}
+			return next(ctx, call)
+		}
+	}
+
+	client, err := New(WithBaseURL(ts.URL), WithMiddleware(policy))
+	c.NoErr(err)
+
+	err = client.Profiles.Delete(context.Background(), &DeleteProfileRequest{ProfileID: "abc123"})
+	c.True(errors.Is(err, errDenied))
+}
+
+func TestHTTPClientKeepsAPIKey(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Header.Get("X-Api-Key"), "secret")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	httpClient := &http.Client{}
+	client, err := New(WithBaseURL(ts.URL), WithAPIKey("secret"), WithHTTPClient(httpClient))
+	c.NoErr(err)
+
+	err = client.Profiles.Delete(context.Background(), &DeleteProfileRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+	c.Equal(httpClient.Transport, nil)
+}
+
+func TestOperationHook(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"apple.com","active":true}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	events := []string{}
+	client, err := New(
+		WithBaseURL(ts.URL),
+		WithOperationHook(func(ctx context.Context, op *Operation) (context.Context, func(error)) {
+			events = append(events, "start:"+op.Name())
+			return ctx, func(err error) {
+				events = append(events, "end:"+op.Name())
+			}
+		}),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				err := next(ctx, call)
+				events = append(events, call.Request.Method+":"+call.Operation.Name()+":"+http.StatusText(call.StatusCode))
+				return err
+			}
+		}),
+	)
+	c.NoErr(err)
+
+	err = client.Denylist.AddEntries(context.Background(), &AddDenylistEntriesRequest{
+		ProfileID: "abc123",
+		Denylist:  []*Denylist{{ID: "tiktok.com", Active: true}},
+	})
+	c.NoErr(err)
+
+	// The calls made by the method keep its operation, instead of the ones of the methods it calls.
+	c.Equal(events, []string{
+		"start:Denylist.AddEntries",
+		"GET:Denylist.AddEntries:OK",
+		"POST:Denylist.AddEntries:No Content",
+		"end:Denylist.AddEntries",
+	})
+
+	// The hooks are only called for the methods making several calls.
+	events = []string{}
+	err = client.Denylist.Delete(context.Background(), &DeleteDenylistRequest{ProfileID: "abc123", ID: "apple.com"})
+	c.NoErr(err)
+	c.Equal(events, []string{"DELETE:Denylist.Delete:No Content"})
+}
+
+// roundTripperFunc is an http.RoundTripper implemented by a function.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport(t *testing.T) {
+	c := is.New(t)
+
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Header.Get("X-Api-Key"), "secret")
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":[]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	events := []string{}
+	client, err := New(
+		WithBaseURL(ts.URL),
+		WithAPIKey("secret"),
+		WithRetryPolicy(&RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}),
+		WithTransport(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				// The API key is added once the transports have run.
+				c.Equal(req.Header.Get("X-Api-Key"), "")
+
+				res, err := next.RoundTrip(req)
+				if err != nil {
+					return nil, err
+				}
+
+				call := CallFromContext(req.Context())
+				events = append(events, call.Operation.Name()+":"+call.Endpoint+":"+http.StatusText(res.StatusCode))
+				return res, nil
+			})
+		}),
+	)
+	c.NoErr(err)
+
+	_, err = client.Denylist.List(context.Background(), &ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	// The transports see each attempt of the call.
+	c.Equal(events, []string{
+		"Denylist.List:profiles/:id/denylist:Service Unavailable",
+		"Denylist.List:profiles/:id/denylist:OK",
+	})
+}
@@ -0,0 +1,92 @@
+package nextdns
+
+import (
+	"context"
+)
+
+// Operation describes the logical NextDNS API operation made by a service method.
+type Operation struct {
+	// Service is the name of the service, e.g. "Denylist".
+	Service string
+
+	// Method is the name of the service method, e.g. "Update".
+	Method string
+
+	// ProfileID is the profile targeted by the operation, if any.
+	ProfileID string
+}
+
+// Name returns the name of the operation, e.g. "Denylist.Update".
+func (o *Operation) Name() string {
+	return o.Service + "." + o.Method
+}
+
+// operationKey is the context key for the operation.
+type operationKey struct{}
+
+// withOperation returns a copy of the context carrying the operation made by a service method.
+// An operation already carried by the context is kept, so the calls a service method makes through other
+// methods (e.g. the List made by Rewrites.Upsert) are attributed to the method called by the caller.
+func withOperation(ctx context.Context, service string, method string, profileID string) context.Context {
+	if operationFromContext(ctx) != nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, operationKey{}, &Operation{
+		Service:   service,
+		Method:    method,
+		ProfileID: profileID,
+	})
+}
+
+// operationFromContext returns the operation carried by the context, if any.
+func operationFromContext(ctx context.Context) *Operation {
+	op, _ := ctx.Value(operationKey{}).(*Operation)
+	return op
+}
+
+// OperationHook is called when a service method making several calls to the NextDNS API starts, e.g. Rewrites.Upsert,
+// with the operation of the method. It returns the context of the calls, and a function called with the error
+// returned by the method once it ends. The calls themselves run through the middlewares, like any other call.
+type OperationHook func(ctx context.Context, op *Operation) (context.Context, func(err error))
+
+// WithOperationHook adds hooks around the service methods making several calls to the NextDNS API.
+// The hooks run in the order they are given, the first one being the outermost.
+func WithOperationHook(hooks ...OperationHook) ClientOption {
+	return func(c *Client) error {
+		for _, h := range hooks {
+			if h != nil {
+				c.operationHooks = append(c.operationHooks, h)
+			}
+		}
+		return nil
+	}
+}
+
+// operationStartedKey is the context key marking that the hooks of the operation of the context were called.
+type operationStartedKey struct{}
+
+// startOperation calls the operation hooks for the service method of the context, and returns the function
+// ending the operation. The hooks are only called once, for the method called by the caller.
+func (c *Client) startOperation(ctx context.Context) (context.Context, func(err error)) {
+	op := operationFromContext(ctx)
+	if len(c.operationHooks) == 0 || op == nil || ctx.Value(operationStartedKey{}) != nil {
+		return ctx, func(error) {}
+	}
+
+	ctx = context.WithValue(ctx, operationStartedKey{}, true)
+	ends := make([]func(error), 0, len(c.operationHooks))
+	for _, hook := range c.operationHooks {
+		var end func(error)
+		ctx, end = hook(ctx, op)
+		ends = append(ends, end)
+	}
+
+	return ctx, func(err error) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			if ends[i] != nil {
+				ends[i](err)
+			}
+		}
+	}
+}
@@ -48,6 +48,24 @@ type UpdateParentalControlRequest struct {
 	ParentalControl *ParentalControl
 }
 
+// ParentalControlPatch represents a partial update of the parental control settings of a profile.
+// Only the fields that are set are sent, the other settings are left unchanged. The lists are set with Slice,
+// an empty one clearing the list.
+type ParentalControlPatch struct {
+	Services              *[]*ParentalControlServices   `json:"services,omitempty"`
+	Categories            *[]*ParentalControlCategories `json:"categories,omitempty"`
+	Recreation            *ParentalControlRecreation    `json:"recreation,omitempty"`
+	SafeSearch            *bool                         `json:"safeSearch,omitempty"`
+	YoutubeRestrictedMode *bool                         `json:"youtubeRestrictedMode,omitempty"`
+	BlockBypass           *bool                         `json:"blockBypass,omitempty"`
+}
+
+// PatchParentalControlRequest encapsulates the request for partially updating the parental control settings of a profile.
+type PatchParentalControlRequest struct {
+	ProfileID       string
+	ParentalControl *ParentalControlPatch
+}
+
 // GetParentalControlRequest encapsulates the request for getting a parental control settings.
 type GetParentalControlRequest struct {
 	ProfileID string
@@ -57,6 +75,7 @@ type GetParentalControlRequest struct {
 type ParentalControlService interface {
 	Get(context.Context, *GetParentalControlRequest) (*ParentalControl, error)
 	Update(context.Context, *UpdateParentalControlRequest) error
+	Patch(context.Context, *PatchParentalControlRequest) error
 }
 
 // parentalControlResponse represents the NextDNS parental control service.
@@ -81,8 +100,9 @@ func NewParentalControlService(client *Client) *parentalControlService {
 
 // Get returns the parental control settings of a profile.
 func (s *parentalControlService) Get(ctx context.Context, request *GetParentalControlRequest) (*ParentalControl, error) {
+	ctx = withOperation(ctx, "ParentalControl", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the parentalControl: %w", err)
 	}
@@ -97,9 +117,11 @@ func (s *parentalControlService) Get(ctx context.Context, request *GetParentalCo
 }
 
 // Update updates the parental control settings of a profile.
+// The false fields are sent as well and disable their setting; use Patch to change only some of them.
 func (s *parentalControlService) Update(ctx context.Context, request *UpdateParentalControlRequest) error {
+	ctx = withOperation(ctx, "ParentalControl", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.ParentalControl)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.ParentalControl)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the parentalControl: %w", err)
 	}
@@ -112,3 +134,20 @@ func (s *parentalControlService) Update(ctx context.Context, request *UpdatePare
 
 	return nil
 }
+
+// Patch updates the parental control settings of a profile that are set in the patch, leaving the other ones unchanged.
+func (s *parentalControlService) Patch(ctx context.Context, request *PatchParentalControlRequest) error {
+	ctx = withOperation(ctx, "ParentalControl", "Patch", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlAPIPath)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.ParentalControl)
+	if err != nil {
+		return fmt.Errorf("error creating request to patch the parentalControl: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to patch the parentalControl: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -20,6 +21,9 @@ type ParentalControlCategories struct {
 type CreateParentalControlCategoriesRequest struct {
 	ProfileID                 string
 	ParentalControlCategories []*ParentalControlCategories
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // UpdateParentalControlCategoriesRequest encapsulates the request for updating a parental control categories list.
@@ -34,11 +38,54 @@ type ListParentalControlCategoriesRequest struct {
 	ProfileID string
 }
 
+// AddParentalControlCategoriesRequest encapsulates the request for adding a category to the parental control categories.
+type AddParentalControlCategoriesRequest struct {
+	ProfileID                 string
+	ParentalControlCategories *ParentalControlCategories
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeleteParentalControlCategoriesRequest encapsulates the request for deleting a category from the parental control categories.
+type DeleteParentalControlCategoriesRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
+// AddParentalControlCategoriesEntriesRequest encapsulates the request for adding categories to the parental control categories, keeping the other categories.
+type AddParentalControlCategoriesEntriesRequest struct {
+	ProfileID                 string
+	ParentalControlCategories []*ParentalControlCategories
+}
+
+// RemoveParentalControlCategoriesEntriesRequest encapsulates the request for removing categories from the parental control categories, keeping the other categories.
+type RemoveParentalControlCategoriesEntriesRequest struct {
+	ProfileID string
+	IDs       []string
+}
+
+// SetParentalControlCategoriesActiveRequest encapsulates the request for activating or deactivating categories of the parental control categories.
+type SetParentalControlCategoriesActiveRequest struct {
+	ProfileID string
+	IDs       []string
+	Active    bool
+}
+
 // ParentalControlCategoriesService is an interface for communicating with the NextDNS parental control categories API endpoint.
 type ParentalControlCategoriesService interface {
 	Create(context.Context, *CreateParentalControlCategoriesRequest) error
 	List(context.Context, *ListParentalControlCategoriesRequest) ([]*ParentalControlCategories, error)
+	All(context.Context, *ListParentalControlCategoriesRequest) iter.Seq2[*ParentalControlCategories, error]
 	Update(context.Context, *UpdateParentalControlCategoriesRequest) error
+	Add(context.Context, *AddParentalControlCategoriesRequest) error
+	Delete(context.Context, *DeleteParentalControlCategoriesRequest) error
+	AddEntries(context.Context, *AddParentalControlCategoriesEntriesRequest) error
+	RemoveEntries(context.Context, *RemoveParentalControlCategoriesEntriesRequest) error
+	SetActive(context.Context, *SetParentalControlCategoriesActiveRequest) error
 }
 
 // parentalControlCategoriesResponse represents the parental control categories response.
@@ -63,14 +110,15 @@ func NewParentalControlCategoriesService(client *Client) *parentalControlCategor
 
 // Create creates a parental control categories list.
 func (s *parentalControlCategoriesService) Create(ctx context.Context, request *CreateParentalControlCategoriesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlCategories", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlCategoriesAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.ParentalControlCategories)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.ParentalControlCategories)
 	if err != nil {
 		return fmt.Errorf("error creating request to create a parental control categories: %w", err)
 	}
 
 	response := parentalControlCategoriesResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create a parental control categories: %w", err)
 	}
@@ -80,8 +128,9 @@ func (s *parentalControlCategoriesService) Create(ctx context.Context, request *
 
 // List returns a parental control categories list.
 func (s *parentalControlCategoriesService) List(ctx context.Context, request *ListParentalControlCategoriesRequest) ([]*ParentalControlCategories, error) {
+	ctx = withOperation(ctx, "ParentalControlCategories", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlCategoriesAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the parental control categories: %w", err)
 	}
@@ -95,10 +144,18 @@ func (s *parentalControlCategoriesService) List(ctx context.Context, request *Li
 	return response.ParentalControlCategories, nil
 }
 
+// All returns an iterator over the parental control categories list.
+func (s *parentalControlCategoriesService) All(ctx context.Context, request *ListParentalControlCategoriesRequest) iter.Seq2[*ParentalControlCategories, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*ParentalControlCategories, error) {
+		return s.List(ctx, request)
+	})
+}
+
 // Update updates a parental control categories list.
 func (s *parentalControlCategoriesService) Update(ctx context.Context, request *UpdateParentalControlCategoriesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlCategories", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlCategoriesIDAPIPath(request.ID))
-	req, err := s.client.newRequest(http.MethodPatch, path, request.ParentalControlCategories)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.ParentalControlCategories)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the parental control categories: %w", err)
 	}
@@ -112,7 +169,120 @@ func (s *parentalControlCategoriesService) Update(ctx context.Context, request *
 	return nil
 }
 
+// Add adds a category to the parental control categories.
+func (s *parentalControlCategoriesService) Add(ctx context.Context, request *AddParentalControlCategoriesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlCategories", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlCategoriesAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.ParentalControlCategories)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the parental control categories: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the parental control categories: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a category from the parental control categories.
+func (s *parentalControlCategoriesService) Delete(ctx context.Context, request *DeleteParentalControlCategoriesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlCategories", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlCategoriesIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the parental control categories id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the parental control categories id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// AddEntries adds categories to the parental control categories of a profile. Unlike Create, which replaces the whole list, only the
+// missing categories and the ones whose settings differ are sent, so the other categories are left as they are.
+func (s *parentalControlCategoriesService) AddEntries(ctx context.Context, request *AddParentalControlCategoriesEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlCategories", "AddEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).addEntries(ctx, request.ParentalControlCategories)
+	if err != nil {
+		return fmt.Errorf("error adding the entries of the parental control categories: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntries removes categories from the parental control categories of a profile, only deleting the ones that are in the list.
+func (s *parentalControlCategoriesService) RemoveEntries(ctx context.Context, request *RemoveParentalControlCategoriesEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlCategories", "RemoveEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).removeEntries(ctx, request.IDs)
+	if err != nil {
+		return fmt.Errorf("error removing the entries of the parental control categories: %w", err)
+	}
+
+	return nil
+}
+
+// SetActive activates or deactivates categories of the parental control categories of a profile, only updating the ones that differ.
+// It returns ErrNotFound, without updating any of them, when some categories are not in the list.
+func (s *parentalControlCategoriesService) SetActive(ctx context.Context, request *SetParentalControlCategoriesActiveRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlCategories", "SetActive", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).setActiveEntries(ctx, request.IDs, request.Active)
+	if err != nil {
+		return fmt.Errorf("error setting the active entries of the parental control categories: %w", err)
+	}
+
+	return nil
+}
+
+// merge returns the per-item operations used to merge changes into the parental control categories of a profile.
+func (s *parentalControlCategoriesService) merge(profileID string) listMerge[ParentalControlCategories] {
+	return listMerge[ParentalControlCategories]{
+		list: func(ctx context.Context) ([]*ParentalControlCategories, error) {
+			return s.List(ctx, &ListParentalControlCategoriesRequest{ProfileID: profileID})
+		},
+		add: func(ctx context.Context, item *ParentalControlCategories) error {
+			return s.Add(ctx, &AddParentalControlCategoriesRequest{ProfileID: profileID, ParentalControlCategories: item, IgnoreDuplicates: true})
+		},
+		update: func(ctx context.Context, item *ParentalControlCategories) error {
+			return s.Update(ctx, &UpdateParentalControlCategoriesRequest{ProfileID: profileID, ID: item.ID, ParentalControlCategories: item})
+		},
+		remove: func(ctx context.Context, id string) error {
+			return s.Delete(ctx, &DeleteParentalControlCategoriesRequest{ProfileID: profileID, ID: id, IgnoreNotFound: true})
+		},
+		id: func(item *ParentalControlCategories) string {
+			return item.ID
+		},
+		equal: func(a *ParentalControlCategories, b *ParentalControlCategories) bool {
+			return *a == *b
+		},
+		setActive: func(item *ParentalControlCategories, active bool) *ParentalControlCategories {
+			updated := *item
+			updated.Active = active
+			return &updated
+		},
+	}
+}
+
 // parentalControlCategoriesIDAPIPath returns the HTTP path for the parental control categories API.
 func parentalControlCategoriesIDAPIPath(id string) string {
-	return fmt.Sprintf("%s/%s", parentalControlCategoriesAPIPath, id)
+	return itemAPIPath(parentalControlCategoriesAPIPath, id)
 }
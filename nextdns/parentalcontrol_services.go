@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -20,6 +21,9 @@ type ParentalControlServices struct {
 type CreateParentalControlServicesRequest struct {
 	ProfileID               string
 	ParentalControlServices []*ParentalControlServices
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // UpdateParentalControlServicesRequest encapsulates the request for updating a parental control services list.
@@ -34,11 +38,54 @@ type ListParentalControlServicesRequest struct {
 	ProfileID string
 }
 
+// AddParentalControlServicesRequest encapsulates the request for adding a service to the parental control services.
+type AddParentalControlServicesRequest struct {
+	ProfileID               string
+	ParentalControlServices *ParentalControlServices
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeleteParentalControlServicesRequest encapsulates the request for deleting a service from the parental control services.
+type DeleteParentalControlServicesRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
+// AddParentalControlServicesEntriesRequest encapsulates the request for adding services to the parental control services, keeping the other services.
+type AddParentalControlServicesEntriesRequest struct {
+	ProfileID               string
+	ParentalControlServices []*ParentalControlServices
+}
+
+// RemoveParentalControlServicesEntriesRequest encapsulates the request for removing services from the parental control services, keeping the other services.
+type RemoveParentalControlServicesEntriesRequest struct {
+	ProfileID string
+	IDs       []string
+}
+
+// SetParentalControlServicesActiveRequest encapsulates the request for activating or deactivating services of the parental control services.
+type SetParentalControlServicesActiveRequest struct {
+	ProfileID string
+	IDs       []string
+	Active    bool
+}
+
 // ParentalControlServicesService is an interface for communicating with the NextDNS parental control services API endpoint.
 type ParentalControlServicesService interface {
 	Create(context.Context, *CreateParentalControlServicesRequest) error
 	List(context.Context, *ListParentalControlServicesRequest) ([]*ParentalControlServices, error)
+	All(context.Context, *ListParentalControlServicesRequest) iter.Seq2[*ParentalControlServices, error]
 	Update(context.Context, *UpdateParentalControlServicesRequest) error
+	Add(context.Context, *AddParentalControlServicesRequest) error
+	Delete(context.Context, *DeleteParentalControlServicesRequest) error
+	AddEntries(context.Context, *AddParentalControlServicesEntriesRequest) error
+	RemoveEntries(context.Context, *RemoveParentalControlServicesEntriesRequest) error
+	SetActive(context.Context, *SetParentalControlServicesActiveRequest) error
 }
 
 // parentalControlServicesResponse represents the NextDNS parental control services service.
@@ -63,14 +110,15 @@ func NewParentalControlServicesService(client *Client) *parentalControlServicesS
 
 // Create creates a parental control services list.
 func (s *parentalControlServicesService) Create(ctx context.Context, request *CreateParentalControlServicesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlServices", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlServicesAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.ParentalControlServices)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.ParentalControlServices)
 	if err != nil {
 		return fmt.Errorf("error creating request to create a parental control services: %w", err)
 	}
 
 	response := parentalControlServicesResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create a parental control services: %w", err)
 	}
@@ -80,8 +128,9 @@ func (s *parentalControlServicesService) Create(ctx context.Context, request *Cr
 
 // List returns a parental control services list.
 func (s *parentalControlServicesService) List(ctx context.Context, request *ListParentalControlServicesRequest) ([]*ParentalControlServices, error) {
+	ctx = withOperation(ctx, "ParentalControlServices", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlServicesAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the parental control services: %w", err)
 	}
@@ -95,10 +144,18 @@ func (s *parentalControlServicesService) List(ctx context.Context, request *List
 	return response.ParentalControlServices, nil
 }
 
+// All returns an iterator over the parental control services list.
+func (s *parentalControlServicesService) All(ctx context.Context, request *ListParentalControlServicesRequest) iter.Seq2[*ParentalControlServices, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*ParentalControlServices, error) {
+		return s.List(ctx, request)
+	})
+}
+
 // Update updates a parental control services list.
 func (s *parentalControlServicesService) Update(ctx context.Context, request *UpdateParentalControlServicesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlServices", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlServicesIDAPIPath(request.ID))
-	req, err := s.client.newRequest(http.MethodPatch, path, request.ParentalControlServices)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.ParentalControlServices)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the parental control services: %w", err)
 	}
@@ -112,7 +169,120 @@ func (s *parentalControlServicesService) Update(ctx context.Context, request *Up
 	return nil
 }
 
+// Add adds a service to the parental control services.
+func (s *parentalControlServicesService) Add(ctx context.Context, request *AddParentalControlServicesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlServices", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlServicesAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.ParentalControlServices)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the parental control services: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the parental control services: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a service from the parental control services.
+func (s *parentalControlServicesService) Delete(ctx context.Context, request *DeleteParentalControlServicesRequest) error {
+	ctx = withOperation(ctx, "ParentalControlServices", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), parentalControlServicesIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the parental control services id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the parental control services id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// AddEntries adds services to the parental control services of a profile. Unlike Create, which replaces the whole list, only the
+// missing services and the ones whose settings differ are sent, so the other services are left as they are.
+func (s *parentalControlServicesService) AddEntries(ctx context.Context, request *AddParentalControlServicesEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlServices", "AddEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).addEntries(ctx, request.ParentalControlServices)
+	if err != nil {
+		return fmt.Errorf("error adding the entries of the parental control services: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntries removes services from the parental control services of a profile, only deleting the ones that are in the list.
+func (s *parentalControlServicesService) RemoveEntries(ctx context.Context, request *RemoveParentalControlServicesEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlServices", "RemoveEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).removeEntries(ctx, request.IDs)
+	if err != nil {
+		return fmt.Errorf("error removing the entries of the parental control services: %w", err)
+	}
+
+	return nil
+}
+
+// SetActive activates or deactivates services of the parental control services of a profile, only updating the ones that differ.
+// It returns ErrNotFound, without updating any of them, when some services are not in the list.
+func (s *parentalControlServicesService) SetActive(ctx context.Context, request *SetParentalControlServicesActiveRequest) (err error) {
+	ctx = withOperation(ctx, "ParentalControlServices", "SetActive", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).setActiveEntries(ctx, request.IDs, request.Active)
+	if err != nil {
+		return fmt.Errorf("error setting the active entries of the parental control services: %w", err)
+	}
+
+	return nil
+}
+
+// merge returns the per-item operations used to merge changes into the parental control services of a profile.
+func (s *parentalControlServicesService) merge(profileID string) listMerge[ParentalControlServices] {
+	return listMerge[ParentalControlServices]{
+		list: func(ctx context.Context) ([]*ParentalControlServices, error) {
+			return s.List(ctx, &ListParentalControlServicesRequest{ProfileID: profileID})
+		},
+		add: func(ctx context.Context, item *ParentalControlServices) error {
+			return s.Add(ctx, &AddParentalControlServicesRequest{ProfileID: profileID, ParentalControlServices: item, IgnoreDuplicates: true})
+		},
+		update: func(ctx context.Context, item *ParentalControlServices) error {
+			return s.Update(ctx, &UpdateParentalControlServicesRequest{ProfileID: profileID, ID: item.ID, ParentalControlServices: item})
+		},
+		remove: func(ctx context.Context, id string) error {
+			return s.Delete(ctx, &DeleteParentalControlServicesRequest{ProfileID: profileID, ID: id, IgnoreNotFound: true})
+		},
+		id: func(item *ParentalControlServices) string {
+			return item.ID
+		},
+		equal: func(a *ParentalControlServices, b *ParentalControlServices) bool {
+			return *a == *b
+		},
+		setActive: func(item *ParentalControlServices, active bool) *ParentalControlServices {
+			updated := *item
+			updated.Active = active
+			return &updated
+		},
+	}
+}
+
 // parentalControlServicesIDAPIPath returns the HTTP path for the parental control services API.
 func parentalControlServicesIDAPIPath(id string) string {
-	return fmt.Sprintf("%s/%s", parentalControlServicesAPIPath, id)
+	return itemAPIPath(parentalControlServicesAPIPath, id)
 }
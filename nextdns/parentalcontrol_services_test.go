@@ -0,0 +1,44 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestParentalControlServicesAddEntries(t *testing.T) {
+	c := is.New(t)
+
+	calls := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"tiktok","active":true,"recreation":false}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	// The recreation time is a setting too, so the service is updated when it differs.
+	err = client.ParentalControlServices.AddEntries(context.Background(), &AddParentalControlServicesEntriesRequest{
+		ProfileID: "abc123",
+		ParentalControlServices: []*ParentalControlServices{
+			{ID: "tiktok", Active: true, Recreation: true},
+			{ID: "fortnite", Active: true},
+		},
+	})
+	c.NoErr(err)
+	c.Equal(calls, []string{
+		"PATCH /profiles/abc123/parentalControl/services/tiktok",
+		"POST /profiles/abc123/parentalControl/services",
+	})
+}
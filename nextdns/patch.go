@@ -0,0 +1,25 @@
+package nextdns
+
+// Bool returns a pointer to the bool value, to set the optional fields of the patches.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to the int value, to set the optional fields of the patches.
+func Int(v int) *int {
+	return &v
+}
+
+// String returns a pointer to the string value, to set the optional fields of the patches.
+func String(v string) *string {
+	return &v
+}
+
+// Slice returns a pointer to the slice of the values, to set the optional list fields of the patches.
+// A nil pointer leaves the list unchanged, while a pointer to an empty slice clears it.
+func Slice[T any](v ...T) *[]T {
+	if v == nil {
+		v = []T{}
+	}
+	return &v
+}
@@ -23,6 +23,22 @@ type UpdatePrivacyRequest struct {
 	Privacy   *Privacy
 }
 
+// PrivacyPatch represents a partial update of the privacy settings of a profile.
+// Only the fields that are set are sent, the other settings are left unchanged. The lists are set with Slice,
+// an empty one clearing the list.
+type PrivacyPatch struct {
+	Blocklists        *[]*PrivacyBlocklists `json:"blocklists,omitempty"`
+	Natives           *[]*PrivacyNatives    `json:"natives,omitempty"`
+	DisguisedTrackers *bool                 `json:"disguisedTrackers,omitempty"`
+	AllowAffiliate    *bool                 `json:"allowAffiliate,omitempty"`
+}
+
+// PatchPrivacyRequest encapsulates the request for partially updating the privacy settings of a profile.
+type PatchPrivacyRequest struct {
+	ProfileID string
+	Privacy   *PrivacyPatch
+}
+
 // GetPrivacyRequest encapsulates the request for getting the privacy settings of a profile.
 type GetPrivacyRequest struct {
 	ProfileID string
@@ -32,6 +48,7 @@ type GetPrivacyRequest struct {
 type PrivacyService interface {
 	Get(context.Context, *GetPrivacyRequest) (*Privacy, error)
 	Update(context.Context, *UpdatePrivacyRequest) error
+	Patch(context.Context, *PatchPrivacyRequest) error
 }
 
 // privacyResponse represents the NextDNS privacy settings service.
@@ -56,8 +73,9 @@ func NewPrivacyService(client *Client) *privacyService {
 
 // Get returns the privacy settings of a profile.
 func (s *privacyService) Get(ctx context.Context, request *GetPrivacyRequest) (*Privacy, error) {
+	ctx = withOperation(ctx, "Privacy", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the privacy: %w", err)
 	}
@@ -72,9 +90,11 @@ func (s *privacyService) Get(ctx context.Context, request *GetPrivacyRequest) (*
 }
 
 // Update updates the privacy settings of a profile.
+// The false fields are sent as well and disable their setting; use Patch to change only some of them.
 func (s *privacyService) Update(ctx context.Context, request *UpdatePrivacyRequest) error {
+	ctx = withOperation(ctx, "Privacy", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Privacy)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Privacy)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the privacy: %w", err)
 	}
@@ -87,3 +107,20 @@ func (s *privacyService) Update(ctx context.Context, request *UpdatePrivacyReque
 
 	return nil
 }
+
+// Patch updates the privacy settings of a profile that are set in the patch, leaving the other ones unchanged.
+func (s *privacyService) Patch(ctx context.Context, request *PatchPrivacyRequest) error {
+	ctx = withOperation(ctx, "Privacy", "Patch", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyAPIPath)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Privacy)
+	if err != nil {
+		return fmt.Errorf("error creating request to patch the privacy: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to patch the privacy: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -23,6 +24,9 @@ type PrivacyBlocklists struct {
 type CreatePrivacyBlocklistsRequest struct {
 	ProfileID         string
 	PrivacyBlocklists []*PrivacyBlocklists
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // ListPrivacyBlocklistsRequest encapsulates the request for getting the privacy blocklist.
@@ -30,10 +34,45 @@ type ListPrivacyBlocklistsRequest struct {
 	ProfileID string
 }
 
+// AddPrivacyBlocklistsRequest encapsulates the request for adding a blocklist to the privacy blocklists.
+type AddPrivacyBlocklistsRequest struct {
+	ProfileID         string
+	PrivacyBlocklists *PrivacyBlocklists
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeletePrivacyBlocklistsRequest encapsulates the request for deleting a blocklist from the privacy blocklists.
+type DeletePrivacyBlocklistsRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
+// AddPrivacyBlocklistsEntriesRequest encapsulates the request for adding blocklists to the privacy blocklists, keeping the other blocklists.
+type AddPrivacyBlocklistsEntriesRequest struct {
+	ProfileID         string
+	PrivacyBlocklists []*PrivacyBlocklists
+}
+
+// RemovePrivacyBlocklistsEntriesRequest encapsulates the request for removing blocklists from the privacy blocklists, keeping the other blocklists.
+type RemovePrivacyBlocklistsEntriesRequest struct {
+	ProfileID string
+	IDs       []string
+}
+
 // PrivacyBlocklistsService is an interface for communicating with the NextDNS privacy blocklist API endpoint.
 type PrivacyBlocklistsService interface {
 	Create(context.Context, *CreatePrivacyBlocklistsRequest) error
 	List(context.Context, *ListPrivacyBlocklistsRequest) ([]*PrivacyBlocklists, error)
+	All(context.Context, *ListPrivacyBlocklistsRequest) iter.Seq2[*PrivacyBlocklists, error]
+	Add(context.Context, *AddPrivacyBlocklistsRequest) error
+	Delete(context.Context, *DeletePrivacyBlocklistsRequest) error
+	AddEntries(context.Context, *AddPrivacyBlocklistsEntriesRequest) error
+	RemoveEntries(context.Context, *RemovePrivacyBlocklistsEntriesRequest) error
 }
 
 // privacyBlocklistsResponse represents the NextDNS privacy blocklist service.
@@ -58,14 +97,15 @@ func NewPrivacyBlocklistsService(client *Client) *privacyBlocklistsService {
 
 // Create creates a privacy blocklist list for a profile.
 func (s *privacyBlocklistsService) Create(ctx context.Context, request *CreatePrivacyBlocklistsRequest) error {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyBlocklistsAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.PrivacyBlocklists)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.PrivacyBlocklists)
 	if err != nil {
 		return fmt.Errorf("error creating request to create a privacy blocklist: %w", err)
 	}
 
 	response := privacyBlocklistsResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create a privacy blocklist: %w", err)
 	}
@@ -75,8 +115,9 @@ func (s *privacyBlocklistsService) Create(ctx context.Context, request *CreatePr
 
 // List returns the privacy blocklist for a profile.
 func (s *privacyBlocklistsService) List(ctx context.Context, request *ListPrivacyBlocklistsRequest) ([]*PrivacyBlocklists, error) {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyBlocklistsAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the privacy blocklist: %w", err)
 	}
@@ -89,3 +130,100 @@ func (s *privacyBlocklistsService) List(ctx context.Context, request *ListPrivac
 
 	return response.PrivacyBlocklists, nil
 }
+
+// All returns an iterator over the privacy blocklist for a profile.
+func (s *privacyBlocklistsService) All(ctx context.Context, request *ListPrivacyBlocklistsRequest) iter.Seq2[*PrivacyBlocklists, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*PrivacyBlocklists, error) {
+		return s.List(ctx, request)
+	})
+}
+
+// Add adds a blocklist to the privacy blocklists.
+func (s *privacyBlocklistsService) Add(ctx context.Context, request *AddPrivacyBlocklistsRequest) error {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyBlocklistsAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.PrivacyBlocklists)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the privacy blocklists: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the privacy blocklists: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a blocklist from the privacy blocklists.
+func (s *privacyBlocklistsService) Delete(ctx context.Context, request *DeletePrivacyBlocklistsRequest) error {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyBlocklistsIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the privacy blocklists id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the privacy blocklists id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// AddEntries adds blocklists to the privacy blocklists of a profile. Unlike Create, which replaces the whole list,
+// only the missing blocklists are added, so the other blocklists are left as they are.
+func (s *privacyBlocklistsService) AddEntries(ctx context.Context, request *AddPrivacyBlocklistsEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "AddEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).addEntries(ctx, request.PrivacyBlocklists)
+	if err != nil {
+		return fmt.Errorf("error adding the entries of the privacy blocklists: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntries removes blocklists from the privacy blocklists of a profile, only deleting the ones that are in the list.
+func (s *privacyBlocklistsService) RemoveEntries(ctx context.Context, request *RemovePrivacyBlocklistsEntriesRequest) (err error) {
+	ctx = withOperation(ctx, "PrivacyBlocklists", "RemoveEntries", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	err = s.merge(request.ProfileID).removeEntries(ctx, request.IDs)
+	if err != nil {
+		return fmt.Errorf("error removing the entries of the privacy blocklists: %w", err)
+	}
+
+	return nil
+}
+
+// merge returns the per-item operations used to merge changes into the privacy blocklists of a profile.
+func (s *privacyBlocklistsService) merge(profileID string) listMerge[PrivacyBlocklists] {
+	return listMerge[PrivacyBlocklists]{
+		list: func(ctx context.Context) ([]*PrivacyBlocklists, error) {
+			return s.List(ctx, &ListPrivacyBlocklistsRequest{ProfileID: profileID})
+		},
+		add: func(ctx context.Context, item *PrivacyBlocklists) error {
+			return s.Add(ctx, &AddPrivacyBlocklistsRequest{ProfileID: profileID, PrivacyBlocklists: item, IgnoreDuplicates: true})
+		},
+		remove: func(ctx context.Context, id string) error {
+			return s.Delete(ctx, &DeletePrivacyBlocklistsRequest{ProfileID: profileID, ID: id, IgnoreNotFound: true})
+		},
+		id: func(item *PrivacyBlocklists) string {
+			return item.ID
+		},
+	}
+}
+
+// privacyBlocklistsIDAPIPath returns the HTTP path for the privacy blocklists API.
+func privacyBlocklistsIDAPIPath(id string) string {
+	return itemAPIPath(privacyBlocklistsAPIPath, id)
+}
@@ -0,0 +1,49 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestPrivacyBlocklistsAddRemoveEntries(t *testing.T) {
+	c := is.New(t)
+
+	calls := []string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"nextdns-recommended","name":"NextDNS Ads & Trackers Blocklist","entries":121000}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+
+	err = client.PrivacyBlocklists.AddEntries(ctx, &AddPrivacyBlocklistsEntriesRequest{
+		ProfileID:         "abc123",
+		PrivacyBlocklists: []*PrivacyBlocklists{{ID: "nextdns-recommended"}, {ID: "oisd"}},
+	})
+	c.NoErr(err)
+
+	err = client.PrivacyBlocklists.RemoveEntries(ctx, &RemovePrivacyBlocklistsEntriesRequest{
+		ProfileID: "abc123",
+		IDs:       []string{"nextdns-recommended", "easylist"},
+	})
+	c.NoErr(err)
+
+	c.Equal(calls, []string{
+		"POST /profiles/abc123/privacy/blocklists",
+		"DELETE /profiles/abc123/privacy/blocklists/nextdns-recommended",
+	})
+}
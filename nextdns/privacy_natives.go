@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -18,6 +19,9 @@ type PrivacyNatives struct {
 type CreatePrivacyNativesRequest struct {
 	ProfileID      string
 	PrivacyNatives []*PrivacyNatives
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // ListPrivacyNativesRequest encapsulates the request for getting the privacy native tracking protection list.
@@ -25,10 +29,31 @@ type ListPrivacyNativesRequest struct {
 	ProfileID string
 }
 
+// AddPrivacyNativesRequest encapsulates the request for adding a native tracking protection to the privacy native list.
+type AddPrivacyNativesRequest struct {
+	ProfileID      string
+	PrivacyNatives *PrivacyNatives
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeletePrivacyNativesRequest encapsulates the request for deleting a native tracking protection from the privacy native list.
+type DeletePrivacyNativesRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
 // PrivacyNativesService is an interface for communicating with the NextDNS privacy native tracking protection API endpoint.
 type PrivacyNativesService interface {
 	Create(context.Context, *CreatePrivacyNativesRequest) error
 	List(context.Context, *ListPrivacyNativesRequest) ([]*PrivacyNatives, error)
+	All(context.Context, *ListPrivacyNativesRequest) iter.Seq2[*PrivacyNatives, error]
+	Add(context.Context, *AddPrivacyNativesRequest) error
+	Delete(context.Context, *DeletePrivacyNativesRequest) error
 }
 
 // privacyNativesResponse represents the NextDNS privacy native tracking protection service.
@@ -53,14 +78,15 @@ func NewPrivacyNativesService(client *Client) *privacyNativesService {
 
 // Create creates a privacy native tracking protection list.
 func (s *privacyNativesService) Create(ctx context.Context, request *CreatePrivacyNativesRequest) error {
+	ctx = withOperation(ctx, "PrivacyNatives", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyNativesAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.PrivacyNatives)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.PrivacyNatives)
 	if err != nil {
 		return fmt.Errorf("error creating request to create a privacy native list: %w", err)
 	}
 
 	response := privacyNativesResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create a privacy native list: %w", err)
 	}
@@ -70,8 +96,9 @@ func (s *privacyNativesService) Create(ctx context.Context, request *CreatePriva
 
 // List returns the privacy native tracking protection list.
 func (s *privacyNativesService) List(ctx context.Context, request *ListPrivacyNativesRequest) ([]*PrivacyNatives, error) {
+	ctx = withOperation(ctx, "PrivacyNatives", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyNativesAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the privacy native list: %w", err)
 	}
@@ -84,3 +111,49 @@ func (s *privacyNativesService) List(ctx context.Context, request *ListPrivacyNa
 
 	return response.PrivacyNatives, nil
 }
+
+// All returns an iterator over the privacy native tracking protection list.
+func (s *privacyNativesService) All(ctx context.Context, request *ListPrivacyNativesRequest) iter.Seq2[*PrivacyNatives, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*PrivacyNatives, error) {
+		return s.List(ctx, request)
+	})
+}
+
+// Add adds a native tracking protection to the privacy native list.
+func (s *privacyNativesService) Add(ctx context.Context, request *AddPrivacyNativesRequest) error {
+	ctx = withOperation(ctx, "PrivacyNatives", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyNativesAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.PrivacyNatives)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the privacy native list: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the privacy native list: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a native tracking protection from the privacy native list.
+func (s *privacyNativesService) Delete(ctx context.Context, request *DeletePrivacyNativesRequest) error {
+	ctx = withOperation(ctx, "PrivacyNatives", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), privacyNativesIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the privacy native list id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the privacy native list id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// privacyNativesIDAPIPath returns the HTTP path for the privacy native list API.
+func privacyNativesIDAPIPath(id string) string {
+	return itemAPIPath(privacyNativesAPIPath, id)
+}
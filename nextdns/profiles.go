@@ -3,7 +3,9 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
 )
 
 // profilesService is the HTTP path for the profiles API.
@@ -33,7 +35,13 @@ type GetProfileRequest struct {
 }
 
 // ListProfileRequest encapsulates the request for listing all the profiles.
-type ListProfileRequest struct{}
+type ListProfileRequest struct {
+	// Cursor is the cursor of the page to list, as returned by the previous page.
+	Cursor string `url:"cursor,omitempty"`
+
+	// Limit is the maximum number of profiles per page, or zero to use the API default.
+	Limit int `url:"limit,omitempty"`
+}
 
 // DeleteProfileRequest encapsulates the request for deleting a profile.
 type DeleteProfileRequest struct {
@@ -46,6 +54,9 @@ type ProfilesService interface {
 	Get(context.Context, *GetProfileRequest) (*Profile, error)
 	Update(context.Context, *UpdateProfileRequest) error
 	List(context.Context, *ListProfileRequest) ([]*Profiles, error)
+	ListPage(context.Context, *ListProfileRequest) (*ProfilesPage, error)
+	ListAll(context.Context, *ListProfileRequest) ([]*Profiles, error)
+	All(context.Context, *ListProfileRequest) iter.Seq2[*Profiles, error]
 	Delete(context.Context, *DeleteProfileRequest) error
 }
 
@@ -76,6 +87,14 @@ type Profiles struct {
 	Name        string `json:"name"`
 }
 
+// ProfilesPage represents a page of NextDNS profiles.
+type ProfilesPage struct {
+	Profiles []*Profiles
+
+	// Cursor is the cursor of the next page, or empty if this is the last page.
+	Cursor string
+}
+
 // profileResponse represents the response for the profile from the NextDNS API.
 type profileResponse struct {
 	Profile *Profile `json:"data"`
@@ -89,7 +108,6 @@ type profilesResponse struct {
 			Cursor string `json:"cursor"`
 		} `json:"pagination"`
 	} `json:"meta,omitempty"`
-	Errors ErrorResponse `json:"errors,omitempty"`
 }
 
 // profilesService represents the NextDNS profiles service.
@@ -107,9 +125,62 @@ func NewProfilesService(client *Client) *profilesService {
 	}
 }
 
-// List returns a list of profiles.
+// List returns a page of profiles, the first one unless a cursor is set in the request.
 func (s *profilesService) List(ctx context.Context, request *ListProfileRequest) ([]*Profiles, error) {
-	req, err := s.client.newRequest(http.MethodGet, profilesAPIPath, nil)
+	ctx = withOperation(ctx, "Profiles", "List", "")
+	page, err := s.listPage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Profiles, nil
+}
+
+// ListPage returns a page of profiles along with the cursor of the next page.
+func (s *profilesService) ListPage(ctx context.Context, request *ListProfileRequest) (*ProfilesPage, error) {
+	ctx = withOperation(ctx, "Profiles", "ListPage", "")
+	return s.listPage(ctx, request)
+}
+
+// ListAll returns all the profiles, following the cursors until the last page.
+func (s *profilesService) ListAll(ctx context.Context, request *ListProfileRequest) ([]*Profiles, error) {
+	ctx = withOperation(ctx, "Profiles", "ListAll", "")
+
+	var profiles []*Profiles
+	for profile, err := range s.All(ctx, request) {
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+// All returns an iterator over all the profiles, fetching the pages as the profiles are consumed.
+func (s *profilesService) All(ctx context.Context, request *ListProfileRequest) iter.Seq2[*Profiles, error] {
+	ctx = withOperation(ctx, "Profiles", "All", "")
+
+	first := ListProfileRequest{}
+	if request != nil {
+		first = *request
+	}
+
+	return pageSeq(ctx, s.client, first.Cursor, func(ctx context.Context, cursor string) ([]*Profiles, string, error) {
+		next := first
+		next.Cursor = cursor
+		page, err := s.listPage(ctx, &next)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Profiles, page.Cursor, nil
+	})
+}
+
+// listPage returns a page of profiles.
+func (s *profilesService) listPage(ctx context.Context, request *ListProfileRequest) (*ProfilesPage, error) {
+	req, err := s.client.newRequest(http.MethodGet, profilesAPIPath, request, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the profiles: %w", err)
 	}
@@ -120,12 +191,16 @@ func (s *profilesService) List(ctx context.Context, request *ListProfileRequest)
 		return nil, fmt.Errorf("error making a request to list the profiles: %w", err)
 	}
 
-	return response.Profiles, nil
+	return &ProfilesPage{
+		Profiles: response.Profiles,
+		Cursor:   response.Metadata.Pagination.Cursor,
+	}, nil
 }
 
 // Create creates a profile and returns a profile ID.
 func (s *profilesService) Create(ctx context.Context, request *CreateProfileRequest) (string, error) {
-	req, err := s.client.newRequest(http.MethodPost, profilesAPIPath, request)
+	ctx = withOperation(ctx, "Profiles", "Create", "")
+	req, err := s.client.newRequest(http.MethodPost, profilesAPIPath, nil, request)
 	if err != nil {
 		return "", fmt.Errorf("error creating request to create a profile: %w", err)
 	}
@@ -141,8 +216,9 @@ func (s *profilesService) Create(ctx context.Context, request *CreateProfileRequ
 
 // Update updates the settings of a profile.
 func (s *profilesService) Update(ctx context.Context, request *UpdateProfileRequest) error {
+	ctx = withOperation(ctx, "Profiles", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profilesAPIPath, request.ProfileID)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Profile)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Profile)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the profile: %w", err)
 	}
@@ -158,8 +234,9 @@ func (s *profilesService) Update(ctx context.Context, request *UpdateProfileRequ
 
 // Get returns a profile.
 func (s *profilesService) Get(ctx context.Context, request *GetProfileRequest) (*Profile, error) {
+	ctx = withOperation(ctx, "Profiles", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profilesAPIPath, request.ProfileID)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the profile: %w", err)
 	}
@@ -175,8 +252,9 @@ func (s *profilesService) Get(ctx context.Context, request *GetProfileRequest) (
 
 // Delete deletes a profile.
 func (s *profilesService) Delete(ctx context.Context, request *DeleteProfileRequest) error {
+	ctx = withOperation(ctx, "Profiles", "Delete", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profilesAPIPath, request.ProfileID)
-	req, err := s.client.newRequest(http.MethodDelete, path, nil)
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request to delete the profile: %w", err)
 	}
@@ -193,3 +271,9 @@ func (s *profilesService) Delete(ctx context.Context, request *DeleteProfileRequ
 func profileAPIPath(profile string) string {
 	return fmt.Sprintf("%s/%s", profilesAPIPath, profile)
 }
+
+// itemAPIPath returns the API path of an item of a list, e.g. a domain of the denylist.
+// The ID is escaped, so the IDs with characters reserved in paths (e.g. "/") can't change the path.
+func itemAPIPath(listPath string, id string) string {
+	return fmt.Sprintf("%s/%s", listPath, url.PathEscape(id))
+}
@@ -0,0 +1,121 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// newProfilesServer returns a test server paginating over the given number of profiles.
+func newProfilesServer(t *testing.T, total int) *httptest.Server {
+	t.Helper()
+	c := is.New(t)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.URL.Path, "/profiles")
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = 2
+		}
+
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			start, err = strconv.Atoi(cursor)
+			c.NoErr(err)
+		}
+
+		data := ""
+		end := start + limit
+		if end > total {
+			end = total
+		}
+		for i := start; i < end; i++ {
+			if data != "" {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"id":"p%d","fingerprint":"fp%d","name":"profile %d"}`, i, i, i)
+		}
+
+		cursor := ""
+		if end < total {
+			cursor = strconv.Itoa(end)
+		}
+
+		_, err = fmt.Fprintf(w, `{"data":[%s],"meta":{"pagination":{"cursor":%q}}}`, data, cursor)
+		c.NoErr(err)
+	}))
+}
+
+func TestProfilesList(t *testing.T) {
+	c := is.New(t)
+
+	ts := newProfilesServer(t, 5)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+
+	profiles, err := client.Profiles.List(ctx, &ListProfileRequest{})
+	c.NoErr(err)
+	c.Equal(len(profiles), 2)
+
+	page, err := client.Profiles.ListPage(ctx, &ListProfileRequest{Cursor: "2", Limit: 3})
+	c.NoErr(err)
+	c.Equal(len(page.Profiles), 3)
+	c.Equal(page.Profiles[0].ID, "p2")
+	c.Equal(page.Cursor, "")
+}
+
+func TestProfilesListAll(t *testing.T) {
+	c := is.New(t)
+
+	ts := newProfilesServer(t, 5)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	profiles, err := client.Profiles.ListAll(context.Background(), &ListProfileRequest{})
+	c.NoErr(err)
+	c.Equal(len(profiles), 5)
+	for i, p := range profiles {
+		c.Equal(p.ID, fmt.Sprintf("p%d", i))
+	}
+}
+
+func TestProfilesListAllCancel(t *testing.T) {
+	c := is.New(t)
+
+	ts := newProfilesServer(t, 5)
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	count := 0
+	for _, err := range client.Profiles.All(ctx, &ListProfileRequest{Limit: 2}) {
+		count++
+		if count == 2 {
+			cancel()
+		}
+		if count == 3 {
+			c.Equal(err, context.Canceled)
+		}
+	}
+	c.Equal(count, 3)
+
+	_, err = client.Profiles.ListAll(ctx, nil)
+	c.True(errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,159 @@
+package nextdns
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// encodeQuery encodes the fields of a struct tagged with `url:"name"` into query parameters.
+//
+// The untagged fields are ignored, except the embedded structs whose fields are encoded as if they were declared
+// in the parent struct. With the omitempty option, the zero values are not encoded. Slices are encoded as
+// repeated keys, booleans as "true" or "false", times in RFC 3339, durations in seconds, and the other types
+// implementing fmt.Stringer with their String method. The pointers are encoded as the values they point to,
+// even the zero ones, and the nil pointers are not encoded.
+func encodeQuery(query interface{}) (url.Values, error) {
+	values := url.Values{}
+	if query == nil {
+		return values, nil
+	}
+
+	v := reflect.ValueOf(query)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return values, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: must be a struct, got %s", ErrInvalidQuery, v.Type())
+	}
+
+	err := encodeQueryStruct(values, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// encodeQueryStruct encodes the tagged fields of the struct v into values.
+func encodeQueryStruct(values url.Values, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		tag, ok := field.Tag.Lookup("url")
+		if !ok {
+			if field.Anonymous {
+				for value.Kind() == reflect.Ptr {
+					if value.IsNil() {
+						break
+					}
+					value = value.Elem()
+				}
+				if value.Kind() == reflect.Struct {
+					if err := encodeQueryStruct(values, value); err != nil {
+						return err
+					}
+				}
+			}
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		// The pointers are only empty when they are nil, so a pointer to a zero value is still encoded.
+		pointer := value.Kind() == reflect.Ptr
+		value, ok = indirectQueryValue(value)
+		if !ok || (!pointer && opts == "omitempty" && isEmptyQueryValue(value)) {
+			continue
+		}
+
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for j := 0; j < value.Len(); j++ {
+				item, ok := indirectQueryValue(value.Index(j))
+				if !ok {
+					continue
+				}
+
+				s, err := queryValue(item)
+				if err != nil {
+					return fmt.Errorf("error encoding the query parameter %s: %w", name, err)
+				}
+				values.Add(name, s)
+			}
+			continue
+		}
+
+		s, err := queryValue(value)
+		if err != nil {
+			return fmt.Errorf("error encoding the query parameter %s: %w", name, err)
+		}
+		values.Set(name, s)
+	}
+
+	return nil
+}
+
+// indirectQueryValue returns the value pointed to by v, if it is a pointer, and whether there is a value.
+// The methods of the values are called through their interface, so they must never be called on nil pointers.
+func indirectQueryValue(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+// isEmptyQueryValue reports whether the value is empty, using its IsZero method when it has one.
+func isEmptyQueryValue(v reflect.Value) bool {
+	if z, ok := v.Interface().(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// queryValue returns the value encoded as a query parameter.
+func queryValue(v reflect.Value) (string, error) {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.UTC().Format(time.RFC3339), nil
+	case time.Duration:
+		return strconv.FormatInt(int64(value/time.Second), 10), nil
+	case fmt.Stringer:
+		return value.String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("%w: unsupported type %s", ErrInvalidQuery, v.Type())
+	}
+}
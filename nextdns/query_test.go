@@ -0,0 +1,95 @@
+package nextdns
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	c := is.New(t)
+
+	type embedded struct {
+		Cursor string `url:"cursor,omitempty"`
+	}
+
+	type query struct {
+		embedded
+		ProfileID string
+		Status    []string      `url:"status"`
+		Raw       bool          `url:"raw"`
+		Root      bool          `url:"root,omitempty"`
+		Limit     int           `url:"limit,omitempty"`
+		At        time.Time     `url:"at,omitempty"`
+		Interval  time.Duration `url:"interval,omitempty"`
+		From      Date          `url:"from,omitempty"`
+		To        Date          `url:"to,omitempty"`
+		Ignored   string        `url:"-"`
+	}
+
+	values, err := encodeQuery(&query{
+		embedded:  embedded{Cursor: "abc"},
+		ProfileID: "abc123",
+		Status:    []string{"blocked", "allowed"},
+		At:        time.Date(2023, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+		Interval:  time.Hour,
+		From:      Ago(7 * 24 * time.Hour),
+		Ignored:   "ignored",
+	})
+	c.NoErr(err)
+	c.Equal(values, url.Values{
+		"cursor":   {"abc"},
+		"status":   {"blocked", "allowed"},
+		"raw":      {"false"},
+		"at":       {"2023-01-01T00:00:00Z"},
+		"interval": {"3600"},
+		"from":     {"-7d"},
+	})
+
+	values, err = encodeQuery((*query)(nil))
+	c.NoErr(err)
+	c.Equal(len(values), 0)
+
+	_, err = encodeQuery("limit=10")
+	c.True(errors.Is(err, ErrInvalidQuery))
+
+	_, err = encodeQuery(struct {
+		Filter map[string]string `url:"filter"`
+	}{Filter: map[string]string{"a": "b"}})
+	c.True(errors.Is(err, ErrInvalidQuery))
+}
+
+func TestEncodeQueryPointers(t *testing.T) {
+	c := is.New(t)
+
+	type query struct {
+		Since  *time.Time `url:"since"`
+		Until  *time.Time `url:"until,omitempty"`
+		Limit  *int       `url:"limit,omitempty"`
+		Device *string    `url:"device,omitempty"`
+		Status []*string  `url:"status"`
+	}
+
+	// The nil pointers are not encoded, and never have their methods called.
+	values, err := encodeQuery(&query{})
+	c.NoErr(err)
+	c.Equal(len(values), 0)
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := 0
+	blocked := "blocked"
+	values, err = encodeQuery(&query{
+		Since:  &since,
+		Limit:  &limit,
+		Status: []*string{&blocked, nil},
+	})
+	c.NoErr(err)
+	c.Equal(values, url.Values{
+		"since":  {"2023-01-01T00:00:00Z"},
+		"limit":  {"0"},
+		"status": {"blocked"},
+	})
+}
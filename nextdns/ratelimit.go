@@ -0,0 +1,141 @@
+package nextdns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter represents a client-side token bucket limiter shared by all the services of a client,
+// with an optional sub-limit for each profile.
+type rateLimiter struct {
+	global *rate.Limiter
+
+	profileLimit rate.Limit
+	profileBurst int
+
+	mu       sync.Mutex
+	profiles map[string]*rate.Limiter
+
+	// sweepAt is the number of profile limiters from which the idle ones are evicted.
+	sweepAt int
+}
+
+// minProfileSweep is the minimum number of profile limiters kept before the idle ones are evicted.
+const minProfileSweep = 64
+
+// WithRateLimit limits the requests made by the client to rps requests per second, allowing bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if rps <= 0 || burst < 1 {
+			return ErrInvalidRateLimit
+		}
+
+		c.limiter().global = rate.NewLimiter(rate.Limit(rps), burst)
+		return nil
+	}
+}
+
+// WithProfileRateLimit limits the requests made for each profile to rps requests per second, allowing bursts of up to burst requests.
+// It can be combined with WithRateLimit, so a fan-out over many profiles can't starve the others.
+func WithProfileRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if rps <= 0 || burst < 1 {
+			return ErrInvalidRateLimit
+		}
+
+		l := c.limiter()
+		l.profileLimit = rate.Limit(rps)
+		l.profileBurst = burst
+		return nil
+	}
+}
+
+// limiter returns the rate limiter of the client, creating it if needed.
+func (c *Client) limiter() *rateLimiter {
+	if c.rateLimiter == nil {
+		c.rateLimiter = &rateLimiter{
+			profiles: map[string]*rate.Limiter{},
+		}
+	}
+
+	return c.rateLimiter
+}
+
+// wait blocks until the request for the profile is allowed to proceed, or the context is done.
+func (l *rateLimiter) wait(ctx context.Context, profileID string) error {
+	if l == nil {
+		return nil
+	}
+
+	// Waits on the profile limiter first, so a busy profile doesn't hold tokens of the shared limiter.
+	if r := l.reserve(profileID); r != nil {
+		err := waitReservation(ctx, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	if l.global != nil {
+		return l.global.Wait(ctx)
+	}
+
+	return nil
+}
+
+// reserve reserves a token of the limiter of a profile, or returns nil if there is no per-profile limit.
+// The token is reserved under the lock, so the limiter can't be evicted between its lookup and its use.
+func (l *rateLimiter) reserve(profileID string) *rate.Reservation {
+	if profileID == "" || l.profileLimit == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.profiles[profileID]
+	if !ok {
+		if len(l.profiles) >= l.sweepAt {
+			l.evict(time.Now())
+		}
+
+		limiter = rate.NewLimiter(l.profileLimit, l.profileBurst)
+		l.profiles[profileID] = limiter
+	}
+
+	return limiter.Reserve()
+}
+
+// evict removes the limiters of the profiles whose bucket is full again, as they behave like new ones,
+// so the limiters of the profiles that are no longer used don't accumulate. The lock must be held.
+func (l *rateLimiter) evict(now time.Time) {
+	for profileID, limiter := range l.profiles {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.profiles, profileID)
+		}
+	}
+
+	// The next sweep waits for the map to double, so the sweeps stay amortized over the new profiles.
+	l.sweepAt = max(2*len(l.profiles), minProfileSweep)
+}
+
+// waitReservation blocks until the reservation is ready, or cancels it when the context is done first.
+func waitReservation(ctx context.Context, r *rate.Reservation) error {
+	delay := r.Delay()
+	if delay == 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
+}
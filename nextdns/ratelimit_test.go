@@ -0,0 +1,134 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestRateLimit(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":[]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithRateLimit(20, 1))
+	c.NoErr(err)
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+		c.NoErr(err)
+	}
+
+	c.True(time.Since(start) >= 90*time.Millisecond)
+}
+
+func TestRateLimitContextCancel(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":[]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithRateLimit(0.1, 1))
+	c.NoErr(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+	c.True(err != nil)
+}
+
+func TestProfileRateLimit(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":[]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithRateLimit(1000, 10), WithProfileRateLimit(0.1, 1))
+	c.NoErr(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// The first request of each profile uses its own burst.
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "def456"})
+	c.NoErr(err)
+
+	// The second request of a profile must wait for its own limiter.
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+	c.True(err != nil)
+
+	// Requests without a profile are not affected by the per-profile limit.
+	_, err = client.Profiles.List(context.Background(), &ListProfileRequest{})
+	c.NoErr(err)
+}
+
+func TestRateLimitInvalid(t *testing.T) {
+	c := is.New(t)
+
+	_, err := New(WithRateLimit(0, 1))
+	c.True(errors.Is(err, ErrInvalidRateLimit))
+
+	_, err = New(WithProfileRateLimit(1, 0))
+	c.True(errors.Is(err, ErrInvalidRateLimit))
+}
+
+func TestProfileRateLimitEviction(t *testing.T) {
+	c := is.New(t)
+
+	client, err := New(WithProfileRateLimit(1, 1))
+	c.NoErr(err)
+
+	l := client.rateLimiter
+	ctx := context.Background()
+
+	for i := range minProfileSweep {
+		err = l.wait(ctx, fmt.Sprintf("profile%d", i))
+		c.NoErr(err)
+	}
+
+	// The limiters still waiting for their tokens are kept, and the others are evicted as they behave like new ones.
+	l.evict(time.Now())
+	c.Equal(len(l.profiles), minProfileSweep)
+
+	l.evict(time.Now().Add(time.Second))
+	c.Equal(len(l.profiles), 0)
+}
+
+func TestProfileRateLimitEvictionBounded(t *testing.T) {
+	c := is.New(t)
+
+	// The buckets are full again almost at once, so the limiters are idle when the next sweep runs.
+	client, err := New(WithProfileRateLimit(1e9, 1))
+	c.NoErr(err)
+
+	l := client.rateLimiter
+	for i := range 10 * minProfileSweep {
+		err = l.wait(context.Background(), fmt.Sprintf("profile%d", i))
+		c.NoErr(err)
+	}
+	c.True(len(l.profiles) <= minProfileSweep)
+}
@@ -0,0 +1,167 @@
+package nextdns
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// redactedValue is the value used to mask secrets in the logged events and error metadata.
+const redactedValue = "[REDACTED]"
+
+// defaultRedactedFields are the JSON fields known to hold secrets in the NextDNS API payloads.
+var defaultRedactedFields = []string{"updateToken"}
+
+// defaultRedactedHeaders are the HTTP headers known to hold secrets.
+var defaultRedactedHeaders = []string{"X-Api-Key"}
+
+// redactor masks secrets from the payloads that the client logs or stores.
+type redactor struct {
+	keys    map[string]struct{}
+	paths   [][]string
+	headers []string
+}
+
+// WithRedactedFields masks the values of the given JSON paths in the logged events and the error metadata,
+// in addition to the known secret fields (e.g. updateToken) and headers (e.g. X-Api-Key).
+// A path is a dot-separated list of keys (e.g. "data.ddns"), where "*" matches any key or array element.
+// A path made of a single key matches that key at any depth.
+func WithRedactedFields(paths ...string) ClientOption {
+	return func(c *Client) error {
+		for _, path := range paths {
+			c.redactor.add(path)
+		}
+		return nil
+	}
+}
+
+// newRedactor returns a redactor for the known secret fields and headers.
+func newRedactor() *redactor {
+	r := &redactor{
+		keys:    map[string]struct{}{},
+		headers: defaultRedactedHeaders,
+	}
+
+	for _, field := range defaultRedactedFields {
+		r.add(field)
+	}
+
+	return r
+}
+
+// add adds a JSON path to be redacted.
+func (r *redactor) add(path string) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return
+	}
+
+	parts := strings.Split(path, ".")
+	if len(parts) == 1 {
+		r.keys[path] = struct{}{}
+		return
+	}
+
+	r.paths = append(r.paths, parts)
+}
+
+// body returns the body with the secret values masked.
+// Bodies that are not JSON are returned unchanged.
+func (r *redactor) body(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return string(body)
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&v)
+	if err != nil {
+		return string(body)
+	}
+
+	if !r.walk(v, nil) {
+		return string(body)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(out)
+}
+
+// walk masks the secret values in v, reporting whether anything was masked.
+func (r *redactor) walk(v interface{}, path []string) bool {
+	redacted := false
+
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for key, child := range node {
+			childPath := append(path[:len(path):len(path)], key)
+			if r.match(key, childPath) {
+				node[key] = redactedValue
+				redacted = true
+				continue
+			}
+			if r.walk(child, childPath) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for i, child := range node {
+			childPath := append(path[:len(path):len(path)], strconv.Itoa(i))
+			if r.match("", childPath) {
+				node[i] = redactedValue
+				redacted = true
+				continue
+			}
+			if r.walk(child, childPath) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// match reports whether the key or the path must be redacted.
+func (r *redactor) match(key string, path []string) bool {
+	if _, ok := r.keys[key]; ok {
+		return true
+	}
+
+	for _, pattern := range r.paths {
+		if len(pattern) != len(path) {
+			continue
+		}
+
+		matched := true
+		for i := range pattern {
+			if pattern[i] != "*" && pattern[i] != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// header returns a copy of the header with the secret values masked.
+func (r *redactor) header(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range r.headers {
+		if out.Get(name) != "" {
+			out.Set(name, redactedValue)
+		}
+	}
+
+	return out
+}
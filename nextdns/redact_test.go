@@ -0,0 +1,69 @@
+package nextdns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestRedactorBody(t *testing.T) {
+	c := is.New(t)
+
+	r := newRedactor()
+	r.add("data.ip")
+	r.add("data.servers.*")
+
+	out := r.body([]byte(`{"data":{"servers":["1.1.1.1"],"ip":"1.2.3.4","ddns":"foo.no-ip.org","updateToken":"secret"}}`))
+	c.Equal(out, `{"data":{"ddns":"foo.no-ip.org","ip":"[REDACTED]","servers":["[REDACTED]"],"updateToken":"[REDACTED]"}}`)
+
+	out = r.body([]byte(`[{"nested":{"updateToken":"secret"}}]`))
+	c.Equal(out, `[{"nested":{"updateToken":"[REDACTED]"}}]`)
+
+	// Bodies without secrets or that are not JSON are kept as is.
+	c.Equal(r.body([]byte(`{"data": []}`)), `{"data": []}`)
+	c.Equal(r.body([]byte(`not json`)), `not json`)
+}
+
+func TestRedactorHeader(t *testing.T) {
+	c := is.New(t)
+
+	h := http.Header{}
+	h.Set("X-Api-Key", "secret")
+	h.Set("Accept", contentType)
+
+	out := newRedactor().header(h)
+	c.Equal(out.Get("X-Api-Key"), redactedValue)
+	c.Equal(out.Get("Accept"), contentType)
+	c.Equal(h.Get("X-Api-Key"), "secret")
+}
+
+func TestRedactErrorAndLogs(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"errors":[{"code":"invalid","source":{"parameter":"ddns"}}],"updateToken":"secret","ddns":"foo"}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	client, err := New(WithBaseURL(ts.URL), WithLogger(testLogger(buf)), WithLogBodies(), WithRedactedFields("ddns"))
+	c.NoErr(err)
+
+	err = client.SetupLinkedIP.Update(context.Background(), &UpdateSetupLinkedIPRequest{
+		ProfileID:     "abc123",
+		SetupLinkedIP: &SetupLinkedIP{Ddns: "foo", UpdateToken: "secret"},
+	})
+
+	var clientErr *Error
+	c.True(errors.As(err, &clientErr))
+	c.True(!bytes.Contains([]byte(clientErr.Meta["body"]), []byte("secret")))
+	c.True(!bytes.Contains([]byte(clientErr.Meta["body"]), []byte("foo")))
+	c.True(!bytes.Contains(buf.Bytes(), []byte("secret")))
+}
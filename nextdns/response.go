@@ -0,0 +1,139 @@
+package nextdns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response represents the metadata of a response from the NextDNS API.
+type Response struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header is the HTTP header of the response.
+	Header http.Header
+
+	// RequestID is the ID of the request, if the API returned one.
+	RequestID string
+
+	// RateLimit is the rate limit state parsed from the response headers.
+	RateLimit RateLimit
+
+	// Pagination is the pagination metadata of the response body, if any.
+	Pagination Pagination
+}
+
+// RateLimit represents the rate limit state returned by the NextDNS API.
+// The fields are left empty when the API doesn't return the matching headers.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+
+	// Reset is the time when the current window resets.
+	Reset time.Time
+
+	// RetryAfter is the delay asked by the API before sending another request.
+	RetryAfter time.Duration
+}
+
+// Pagination represents the pagination metadata of a response from the NextDNS API.
+type Pagination struct {
+	// Cursor is the cursor of the next page, or empty if there is no next page.
+	Cursor string `json:"cursor"`
+}
+
+// responseKey is the context key for the response capture.
+type responseKey struct{}
+
+// WithResponse returns a copy of the context that captures the metadata of the responses into res.
+// When several calls are made with the same context, res holds the metadata of the last response.
+//
+//	var res nextdns.Response
+//	list, err := client.Denylist.List(nextdns.WithResponse(ctx, &res), request)
+//	fmt.Println(res.StatusCode, res.RateLimit.Remaining)
+func WithResponse(ctx context.Context, res *Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, res)
+}
+
+// captureResponse fills the response capture of the context, if any.
+func captureResponse(ctx context.Context, res *http.Response, body []byte) {
+	out, ok := ctx.Value(responseKey{}).(*Response)
+	if !ok || out == nil {
+		return
+	}
+
+	*out = *newResponse(res, body)
+}
+
+// newResponse returns the metadata of an HTTP response.
+func newResponse(res *http.Response, body []byte) *Response {
+	out := &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		RateLimit:  parseRateLimit(res.Header),
+	}
+
+	var meta struct {
+		Meta struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
+	}
+	if json.Unmarshal(body, &meta) == nil {
+		out.Pagination = meta.Meta.Pagination
+	}
+
+	return out
+}
+
+// parseRateLimit parses the rate limit headers, supporting both the X-RateLimit-* and RateLimit-* conventions.
+func parseRateLimit(h http.Header) RateLimit {
+	rl := RateLimit{}
+
+	if v, ok := headerInt(h, "X-RateLimit-Limit", "RateLimit-Limit"); ok {
+		rl.Limit = v
+	}
+
+	if v, ok := headerInt(h, "X-RateLimit-Remaining", "RateLimit-Remaining"); ok {
+		rl.Remaining = v
+	}
+
+	// The reset is either a unix timestamp or a number of seconds from now.
+	if v, ok := headerInt(h, "X-RateLimit-Reset", "RateLimit-Reset"); ok {
+		if v > 1_000_000_000 {
+			rl.Reset = time.Unix(int64(v), 0)
+		} else {
+			rl.Reset = time.Now().Add(time.Duration(v) * time.Second)
+		}
+	}
+
+	if wait, ok := retryAfter(h.Get("Retry-After")); ok {
+		rl.RetryAfter = wait
+	}
+
+	return rl
+}
+
+// headerInt returns the integer value of the first of the headers present.
+func headerInt(h http.Header, names ...string) (int, bool) {
+	for _, name := range names {
+		value := h.Get(name)
+		if value == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	}
+
+	return 0, false
+}
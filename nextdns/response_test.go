@@ -0,0 +1,61 @@
+package nextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestResponseCapture(t *testing.T) {
+	c := is.New(t)
+
+	reset := time.Now().Add(time.Minute).Unix()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		_, err := w.Write([]byte(`{"data":[{"id":"abc123","fingerprint":"fp","name":"foo"}],"meta":{"pagination":{"cursor":"next"}}}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var res Response
+	profiles, err := client.Profiles.List(WithResponse(context.Background(), &res), &ListProfileRequest{})
+	c.NoErr(err)
+	c.Equal(len(profiles), 1)
+
+	c.Equal(res.StatusCode, http.StatusOK)
+	c.Equal(res.RequestID, "req-123")
+	c.Equal(res.RateLimit.Limit, 100)
+	c.Equal(res.RateLimit.Remaining, 42)
+	c.Equal(res.RateLimit.Reset.Unix(), reset)
+	c.Equal(res.Pagination.Cursor, "next")
+}
+
+func TestResponseCaptureError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	var res Response
+	_, err = client.Denylist.List(WithResponse(context.Background(), &res), &ListDenylistRequest{ProfileID: "abc123"})
+	c.True(err != nil)
+	c.Equal(res.StatusCode, http.StatusTooManyRequests)
+	c.Equal(res.RateLimit.RetryAfter, 30*time.Second)
+}
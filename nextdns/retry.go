@@ -0,0 +1,206 @@
+package nextdns
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy defines how the client retries requests that failed with a transport error,
+// a rate limit (HTTP 429) or a server error (HTTP 5xx).
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first one.
+	MaxAttempts int
+
+	// MinBackoff is the base delay used by the exponential backoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts, including delays asked by the Retry-After header.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent enables retries for non-idempotent requests (e.g. creating a profile).
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// WithRetryPolicy sets the retry policy used for requests.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy == nil {
+			policy = DefaultRetryPolicy()
+		}
+
+		p := *policy
+		if p.MaxAttempts < 1 {
+			p.MaxAttempts = 1
+		}
+		if p.MinBackoff <= 0 {
+			p.MinBackoff = defaultRetryMinBackoff
+		}
+		if p.MaxBackoff < p.MinBackoff {
+			p.MaxBackoff = p.MinBackoff
+		}
+
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// maxAttempts returns how many times the request can be attempted.
+func (p *RetryPolicy) maxAttempts(req *http.Request) int {
+	if p == nil {
+		return 1
+	}
+
+	// Requests with a body that can't be replayed are only attempted once.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 1
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// backoff returns the delay before the next attempt, honoring the Retry-After header if present.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			if wait > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	// Exponential backoff with full jitter.
+	wait := p.MinBackoff << (attempt - 1)
+	if wait > p.MaxBackoff || wait <= 0 {
+		wait = p.MaxBackoff
+	}
+
+	// nolint: gosec
+	return time.Duration(rand.Int63n(int64(wait) + 1))
+}
+
+// shouldRetry reports whether a response or transport error is worth retrying.
+func shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether the HTTP method is idempotent.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// send executes the HTTP request, retrying it according to the retry policy of the client.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	attempts := c.retryPolicy.maxAttempts(req)
+	profileID := c.profileIDFromURL(req.URL)
+
+	for attempt := 1; ; attempt++ {
+		err := c.rateLimiter.wait(ctx, profileID)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := rewindRequest(ctx, req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.client.Do(r)
+		if attempt >= attempts || ctx.Err() != nil || !shouldRetry(res, err) {
+			return res, err
+		}
+
+		wait := c.retryPolicy.backoff(attempt, res)
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindRequest returns a copy of the request bound to the context, with a fresh body for retries.
+func rewindRequest(ctx context.Context, req *http.Request, attempt int) (*http.Request, error) {
+	r := req.Clone(ctx)
+	if attempt > 1 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	return r, nil
+}
@@ -0,0 +1,151 @@
+package nextdns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+	}
+}
+
+func TestRetryServerError(t *testing.T) {
+	c := is.New(t)
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		c.NoErr(err)
+		c.Equal(string(body), "[{\"id\":\"apple.com\",\"active\":true}]\n")
+		c.Equal(r.Header.Values("X-Api-Key"), []string{"secret"})
+
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithAPIKey("secret"), WithRetryPolicy(testRetryPolicy()))
+	c.NoErr(err)
+
+	err = client.Denylist.Create(context.Background(), &CreateDenylistRequest{
+		ProfileID: "abc123",
+		Denylist:  []*Denylist{{ID: "apple.com", Active: true}},
+	})
+
+	c.NoErr(err)
+	c.Equal(atomic.LoadInt32(&calls), int32(3))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	c := is.New(t)
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, err := w.Write([]byte(`{"errors":[{"code":"tooManyRequests"}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithRetryPolicy(testRetryPolicy()))
+	c.NoErr(err)
+
+	_, err = client.Denylist.List(context.Background(), &ListDenylistRequest{ProfileID: "abc123"})
+
+	c.True(err != nil)
+	c.Equal(atomic.LoadInt32(&calls), int32(3))
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	c := is.New(t)
+
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"id":"abc123"}}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL), WithRetryPolicy(testRetryPolicy()))
+	c.NoErr(err)
+
+	_, err = client.Profiles.Create(context.Background(), &CreateProfileRequest{Name: "foo"})
+	c.True(err != nil)
+	c.Equal(atomic.LoadInt32(&calls), int32(1))
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	client, err = New(WithBaseURL(ts.URL), WithRetryPolicy(policy))
+	c.NoErr(err)
+
+	atomic.StoreInt32(&calls, 0)
+	id, err := client.Profiles.Create(context.Background(), &CreateProfileRequest{Name: "foo"})
+	c.NoErr(err)
+	c.Equal(id, "abc123")
+	c.Equal(atomic.LoadInt32(&calls), int32(2))
+}
+
+func TestRetryContextCancel(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	client, err := New(WithBaseURL(ts.URL), WithRetryPolicy(policy))
+	c.NoErr(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.Denylist.List(ctx, &ListDenylistRequest{ProfileID: "abc123"})
+
+	c.True(err != nil)
+	c.True(time.Since(start) < 5*time.Second)
+}
+
+func TestRetryAfter(t *testing.T) {
+	c := is.New(t)
+
+	wait, ok := retryAfter("2")
+	c.True(ok)
+	c.Equal(wait, 2*time.Second)
+
+	_, ok = retryAfter("")
+	c.True(!ok)
+
+	_, ok = retryAfter("soon")
+	c.True(!ok)
+
+	wait, ok = retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	c.True(ok)
+	c.True(wait > 59*time.Minute)
+
+	policy := testRetryPolicy()
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	c.Equal(policy.backoff(1, res), policy.MaxBackoff)
+}
@@ -3,7 +3,9 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"strings"
 )
 
 // rewritesAPIPath is the HTTP path for the rewrites API.
@@ -21,6 +23,10 @@ type Rewrites struct {
 type CreateRewritesRequest struct {
 	ProfileID string
 	Rewrites  *Rewrites
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	// The returned ID is empty when the rewrite already exists.
+	IgnoreDuplicates bool
 }
 
 // ListRewritesRequest encapsulates the request for getting an rewrites.
@@ -34,11 +40,46 @@ type DeleteRewritesRequest struct {
 	ID        string
 }
 
+// UpdateRewritesRequest encapsulates the request for updating a rewrite.
+type UpdateRewritesRequest struct {
+	ProfileID string
+	ID        string
+	Rewrites  *Rewrites
+}
+
+// UpsertRewritesRequest encapsulates the request for creating a rewrite, or updating the rewrite with the same name.
+type UpsertRewritesRequest struct {
+	ProfileID string
+	Rewrites  *Rewrites
+}
+
+// UpsertAction represents the action taken by an upsert.
+type UpsertAction string
+
+// Actions taken by an upsert.
+const (
+	UpsertActionCreated   UpsertAction = "created"
+	UpsertActionUpdated   UpsertAction = "updated"
+	UpsertActionUnchanged UpsertAction = "unchanged"
+)
+
+// UpsertRewritesResponse represents the result of a rewrite upsert.
+type UpsertRewritesResponse struct {
+	// ID is the ID of the created or updated rewrite.
+	ID string
+
+	// Action is the action taken by the upsert.
+	Action UpsertAction
+}
+
 // RewritesService is an interface for communicating with the NextDNS rewrites API endpoint.
 type RewritesService interface {
 	Create(context.Context, *CreateRewritesRequest) (string, error)
 	List(context.Context, *ListRewritesRequest) ([]*Rewrites, error)
+	All(context.Context, *ListRewritesRequest) iter.Seq2[*Rewrites, error]
 	Delete(context.Context, *DeleteRewritesRequest) error
+	Update(context.Context, *UpdateRewritesRequest) error
+	Upsert(context.Context, *UpsertRewritesRequest) (*UpsertRewritesResponse, error)
 }
 
 // rewritesResponse represents the rewrites response.
@@ -68,26 +109,33 @@ func NewRewritesService(client *Client) *rewritesService {
 
 // Create creates a rewrite and returns its ID.
 func (s *rewritesService) Create(ctx context.Context, request *CreateRewritesRequest) (string, error) {
+	ctx = withOperation(ctx, "Rewrites", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), rewritesAPIPath)
 
-	req, err := s.client.newRequest(http.MethodPost, path, request.Rewrites)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.Rewrites)
 	if err != nil {
 		return "", fmt.Errorf("error creating request to create a rewrite: %w", err)
 	}
 
 	response := &createRewritesResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return "", fmt.Errorf("error making a request to create a rewrite: %w", err)
 	}
 
+	// There is no rewrite in the response when a duplicate is ignored.
+	if response.Rewrites == nil {
+		return "", nil
+	}
+
 	return response.Rewrites.ID, nil
 }
 
 // List returns the rewrites of a profile.
 func (s *rewritesService) List(ctx context.Context, request *ListRewritesRequest) ([]*Rewrites, error) {
+	ctx = withOperation(ctx, "Rewrites", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), rewritesAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the rewrite list: %w", err)
 	}
@@ -101,10 +149,18 @@ func (s *rewritesService) List(ctx context.Context, request *ListRewritesRequest
 	return response.Rewrites, nil
 }
 
+// All returns an iterator over the rewrites of a profile.
+func (s *rewritesService) All(ctx context.Context, request *ListRewritesRequest) iter.Seq2[*Rewrites, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*Rewrites, error) {
+		return s.List(ctx, request)
+	})
+}
+
 // Delete deletes a profile.
 func (s *rewritesService) Delete(ctx context.Context, request *DeleteRewritesRequest) error {
+	ctx = withOperation(ctx, "Rewrites", "Delete", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), rewritesIDAPIPath(request.ID))
-	req, err := s.client.newRequest(http.MethodDelete, path, nil)
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request to delete the rewrite: %w", err)
 	}
@@ -117,7 +173,68 @@ func (s *rewritesService) Delete(ctx context.Context, request *DeleteRewritesReq
 	return err
 }
 
+// Update updates a rewrite of a profile.
+func (s *rewritesService) Update(ctx context.Context, request *UpdateRewritesRequest) error {
+	ctx = withOperation(ctx, "Rewrites", "Update", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), rewritesIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Rewrites)
+	if err != nil {
+		return fmt.Errorf("error creating request to update the rewrite id %s: %w", request.ID, err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to update the rewrite id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// Upsert updates the rewrite with the same name, or creates it when there is none, and reports the action taken.
+// The rewrite is left unchanged when its content is already the requested one.
+func (s *rewritesService) Upsert(ctx context.Context, request *UpsertRewritesRequest) (_ *UpsertRewritesResponse, err error) {
+	ctx = withOperation(ctx, "Rewrites", "Upsert", request.ProfileID)
+	ctx, end := s.client.startOperation(ctx)
+	defer func() {
+		end(err)
+	}()
+
+	rewrites, err := s.List(ctx, &ListRewritesRequest{ProfileID: request.ProfileID})
+	if err != nil {
+		return nil, fmt.Errorf("error upserting the rewrite %s: %w", request.Rewrites.Name, err)
+	}
+
+	for _, existing := range rewrites {
+		// The names are domains, so they are compared case-insensitively.
+		if !strings.EqualFold(existing.Name, request.Rewrites.Name) {
+			continue
+		}
+
+		if existing.Content == request.Rewrites.Content {
+			return &UpsertRewritesResponse{ID: existing.ID, Action: UpsertActionUnchanged}, nil
+		}
+
+		err = s.Update(ctx, &UpdateRewritesRequest{
+			ProfileID: request.ProfileID,
+			ID:        existing.ID,
+			Rewrites:  &Rewrites{Name: existing.Name, Content: request.Rewrites.Content},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error upserting the rewrite %s: %w", request.Rewrites.Name, err)
+		}
+
+		return &UpsertRewritesResponse{ID: existing.ID, Action: UpsertActionUpdated}, nil
+	}
+
+	id, err := s.Create(ctx, &CreateRewritesRequest{ProfileID: request.ProfileID, Rewrites: request.Rewrites})
+	if err != nil {
+		return nil, fmt.Errorf("error upserting the rewrite %s: %w", request.Rewrites.Name, err)
+	}
+
+	return &UpsertRewritesResponse{ID: id, Action: UpsertActionCreated}, nil
+}
+
 // rewritesIDAPIPath returns the HTTP path for the rewrites API.
 func rewritesIDAPIPath(id string) string {
-	return fmt.Sprintf("%s/%s", rewritesAPIPath, id)
+	return itemAPIPath(rewritesAPIPath, id)
 }
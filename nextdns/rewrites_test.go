@@ -0,0 +1,90 @@
+package nextdns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestRewritesUpdate(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Method, http.MethodPatch)
+		c.Equal(r.URL.Path, "/profiles/abc123/rewrites/2d4e5f")
+
+		body := Rewrites{}
+		c.NoErr(json.NewDecoder(r.Body).Decode(&body))
+		c.Equal(body.Content, "10.0.0.2")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Rewrites.Update(context.Background(), &UpdateRewritesRequest{
+		ProfileID: "abc123",
+		ID:        "2d4e5f",
+		Rewrites:  &Rewrites{Name: "nas.home", Content: "10.0.0.2"},
+	})
+	c.NoErr(err)
+}
+
+func TestRewritesUpsert(t *testing.T) {
+	c := is.New(t)
+
+	var methods []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			_, err = w.Write([]byte(`{"data":[{"id":"2d4e5f","name":"nas.home","type":"A","content":"10.0.0.1"}]}`))
+		case http.MethodPatch:
+			c.Equal(r.URL.Path, "/profiles/abc123/rewrites/2d4e5f")
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			_, err = w.Write([]byte(`{"data":{"id":"7a8b9c","name":"printer.home","type":"A","content":"10.0.0.3"}}`))
+		}
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	ctx := context.Background()
+
+	res, err := client.Rewrites.Upsert(ctx, &UpsertRewritesRequest{
+		ProfileID: "abc123",
+		Rewrites:  &Rewrites{Name: "NAS.home", Content: "10.0.0.1"},
+	})
+	c.NoErr(err)
+	c.Equal(res, &UpsertRewritesResponse{ID: "2d4e5f", Action: UpsertActionUnchanged})
+
+	res, err = client.Rewrites.Upsert(ctx, &UpsertRewritesRequest{
+		ProfileID: "abc123",
+		Rewrites:  &Rewrites{Name: "nas.home", Content: "10.0.0.2"},
+	})
+	c.NoErr(err)
+	c.Equal(res, &UpsertRewritesResponse{ID: "2d4e5f", Action: UpsertActionUpdated})
+
+	res, err = client.Rewrites.Upsert(ctx, &UpsertRewritesRequest{
+		ProfileID: "abc123",
+		Rewrites:  &Rewrites{Name: "printer.home", Content: "10.0.0.3"},
+	})
+	c.NoErr(err)
+	c.Equal(res, &UpsertRewritesResponse{ID: "7a8b9c", Action: UpsertActionCreated})
+
+	c.Equal(methods, []string{
+		http.MethodGet,
+		http.MethodGet, http.MethodPatch,
+		http.MethodGet, http.MethodPost,
+	})
+}
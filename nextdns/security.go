@@ -11,19 +11,19 @@ const securityAPIPath = "security"
 
 // Security represents the security settings of a profile.
 type Security struct {
-	ThreatIntelligenceFeeds bool            `json:"threatIntelligenceFeeds"`
-	AiThreatDetection       bool            `json:"aiThreatDetection"`
-	GoogleSafeBrowsing      bool            `json:"googleSafeBrowsing"`
-	Cryptojacking           bool            `json:"cryptojacking"`
-	DNSRebinding            bool            `json:"dnsRebinding"`
-	IdnHomographs           bool            `json:"idnHomographs"`
-	Typosquatting           bool            `json:"typosquatting"`
-	Dga                     bool            `json:"dga"`
-	Nrd                     bool            `json:"nrd"`
-	DDNS                    bool            `json:"ddns"`
-	Parking                 bool            `json:"parking"`
-	Csam                    bool            `json:"csam"`
-	Tlds                    []*SecurityTlds `json:"tlds,omitempty"`
+	ThreatIntelligenceFeeds bool             `json:"threatIntelligenceFeeds"`
+	AiThreatDetection       bool             `json:"aiThreatDetection"`
+	GoogleSafeBrowsing      bool             `json:"googleSafeBrowsing"`
+	Cryptojacking           bool             `json:"cryptojacking"`
+	DNSRebinding            bool             `json:"dnsRebinding"`
+	IdnHomographs           bool             `json:"idnHomographs"`
+	Typosquatting           bool             `json:"typosquatting"`
+	Dga                     bool             `json:"dga"`
+	Nrd                     bool             `json:"nrd"`
+	DDNS                    bool             `json:"ddns"`
+	Parking                 bool             `json:"parking"`
+	Csam                    bool             `json:"csam"`
+	Tlds                    *[]*SecurityTlds `json:"tlds,omitempty"`
 }
 
 // UpdateSecurityRequest encapsulates the request for updating security settings.
@@ -32,6 +32,31 @@ type UpdateSecurityRequest struct {
 	Security  *Security
 }
 
+// SecurityPatch represents a partial update of the security settings of a profile.
+// Only the fields that are set are sent, the other settings are left unchanged. The lists are set with Slice,
+// an empty one clearing the list.
+type SecurityPatch struct {
+	ThreatIntelligenceFeeds *bool            `json:"threatIntelligenceFeeds,omitempty"`
+	AiThreatDetection       *bool            `json:"aiThreatDetection,omitempty"`
+	GoogleSafeBrowsing      *bool            `json:"googleSafeBrowsing,omitempty"`
+	Cryptojacking           *bool            `json:"cryptojacking,omitempty"`
+	DNSRebinding            *bool            `json:"dnsRebinding,omitempty"`
+	IdnHomographs           *bool            `json:"idnHomographs,omitempty"`
+	Typosquatting           *bool            `json:"typosquatting,omitempty"`
+	Dga                     *bool            `json:"dga,omitempty"`
+	Nrd                     *bool            `json:"nrd,omitempty"`
+	DDNS                    *bool            `json:"ddns,omitempty"`
+	Parking                 *bool            `json:"parking,omitempty"`
+	Csam                    *bool            `json:"csam,omitempty"`
+	Tlds                    *[]*SecurityTlds `json:"tlds,omitempty"`
+}
+
+// PatchSecurityRequest encapsulates the request for partially updating the security settings of a profile.
+type PatchSecurityRequest struct {
+	ProfileID string
+	Security  *SecurityPatch
+}
+
 // GetSecurityRequest encapsulates the request for getting a security settings.
 type GetSecurityRequest struct {
 	ProfileID string
@@ -41,6 +66,7 @@ type GetSecurityRequest struct {
 type SecurityService interface {
 	Get(context.Context, *GetSecurityRequest) (*Security, error)
 	Update(context.Context, *UpdateSecurityRequest) error
+	Patch(context.Context, *PatchSecurityRequest) error
 }
 
 // securityResponse represents the security settings response.
@@ -65,8 +91,9 @@ func NewSecurityService(client *Client) *securityService {
 
 // Get returns the security settings of a profile.
 func (s *securityService) Get(ctx context.Context, request *GetSecurityRequest) (*Security, error) {
+	ctx = withOperation(ctx, "Security", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the security settings: %w", err)
 	}
@@ -81,9 +108,11 @@ func (s *securityService) Get(ctx context.Context, request *GetSecurityRequest)
 }
 
 // Update updates the security settings of a profile.
+// Every protection is sent, so the ones left false are disabled; use Patch to change only some of them.
 func (s *securityService) Update(ctx context.Context, request *UpdateSecurityRequest) error {
+	ctx = withOperation(ctx, "Security", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Security)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Security)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the security settings: %w", err)
 	}
@@ -96,3 +125,20 @@ func (s *securityService) Update(ctx context.Context, request *UpdateSecurityReq
 
 	return nil
 }
+
+// Patch updates the security settings of a profile that are set in the patch, leaving the other ones unchanged.
+func (s *securityService) Patch(ctx context.Context, request *PatchSecurityRequest) error {
+	ctx = withOperation(ctx, "Security", "Patch", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityAPIPath)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Security)
+	if err != nil {
+		return fmt.Errorf("error creating request to patch the security settings: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to patch the security settings: %w", err)
+	}
+
+	return nil
+}
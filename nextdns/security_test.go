@@ -0,0 +1,63 @@
+package nextdns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestSecurityPatch(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Equal(r.Method, http.MethodPatch)
+		c.Equal(r.URL.Path, "/profiles/abc123/security")
+
+		body, err := io.ReadAll(r.Body)
+		c.NoErr(err)
+
+		// Only the fields set in the patch are sent.
+		c.Equal(string(body), `{"cryptojacking":true,"nrd":false}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Security.Patch(context.Background(), &PatchSecurityRequest{
+		ProfileID: "abc123",
+		Security: &SecurityPatch{
+			Cryptojacking: Bool(true),
+			Nrd:           Bool(false),
+		},
+	})
+	c.NoErr(err)
+}
+
+func TestSecurityPatchClearTlds(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		c.NoErr(err)
+
+		// An empty list is sent to clear the TLDs, unlike a nil one.
+		c.Equal(string(body), `{"tlds":[]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.Security.Patch(context.Background(), &PatchSecurityRequest{
+		ProfileID: "abc123",
+		Security:  &SecurityPatch{Tlds: Slice[*SecurityTlds]()},
+	})
+	c.NoErr(err)
+}
@@ -3,6 +3,7 @@ package nextdns
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -18,6 +19,9 @@ type SecurityTlds struct {
 type CreateSecurityTldsRequest struct {
 	ProfileID    string
 	SecurityTlds []*SecurityTlds
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the creation is idempotent.
+	IgnoreDuplicates bool
 }
 
 // ListSecurityTldsRequest encapsulates the request for getting a security TLDs list.
@@ -25,10 +29,31 @@ type ListSecurityTldsRequest struct {
 	ProfileID string
 }
 
+// AddSecurityTldsRequest encapsulates the request for adding a TLD to the security TLDs.
+type AddSecurityTldsRequest struct {
+	ProfileID    string
+	SecurityTlds *SecurityTlds
+
+	// IgnoreDuplicates treats "duplicate" errors as success, so the addition is idempotent.
+	IgnoreDuplicates bool
+}
+
+// DeleteSecurityTldsRequest encapsulates the request for deleting a TLD from the security TLDs.
+type DeleteSecurityTldsRequest struct {
+	ProfileID string
+	ID        string
+
+	// IgnoreNotFound treats "not found" errors as success, so the deletion is idempotent.
+	IgnoreNotFound bool
+}
+
 // SecurityTldsService is an interface for communicating with the NextDNS security TLDs API endpoint.
 type SecurityTldsService interface {
 	Create(context.Context, *CreateSecurityTldsRequest) error
 	List(context.Context, *ListSecurityTldsRequest) ([]*SecurityTlds, error)
+	All(context.Context, *ListSecurityTldsRequest) iter.Seq2[*SecurityTlds, error]
+	Add(context.Context, *AddSecurityTldsRequest) error
+	Delete(context.Context, *DeleteSecurityTldsRequest) error
 }
 
 // securityTldsResponse represents the security TLDs response.
@@ -53,14 +78,15 @@ func NewSecurityTldsService(client *Client) *securityTldsService {
 
 // Create creates a security TLDs list.
 func (s *securityTldsService) Create(ctx context.Context, request *CreateSecurityTldsRequest) error {
+	ctx = withOperation(ctx, "SecurityTlds", "Create", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityTldsAPIPath)
-	req, err := s.client.newRequest(http.MethodPut, path, request.SecurityTlds)
+	req, err := s.client.newRequest(http.MethodPut, path, nil, request.SecurityTlds)
 	if err != nil {
 		return fmt.Errorf("error creating request to create a security tlds list: %w", err)
 	}
 
 	response := securityTldsResponse{}
-	err = s.client.do(ctx, req, &response)
+	err = ignoreDuplicate(s.client.do(ctx, req, &response), request.IgnoreDuplicates)
 	if err != nil {
 		return fmt.Errorf("error making a request to create a security tlds list: %w", err)
 	}
@@ -70,8 +96,9 @@ func (s *securityTldsService) Create(ctx context.Context, request *CreateSecurit
 
 // List returns a security TLDs list.
 func (s *securityTldsService) List(ctx context.Context, request *ListSecurityTldsRequest) ([]*SecurityTlds, error) {
+	ctx = withOperation(ctx, "SecurityTlds", "List", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityTldsAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to list the security tlds list: %w", err)
 	}
@@ -84,3 +111,49 @@ func (s *securityTldsService) List(ctx context.Context, request *ListSecurityTld
 
 	return response.SecurityTlds, nil
 }
+
+// All returns an iterator over the security TLDs list.
+func (s *securityTldsService) All(ctx context.Context, request *ListSecurityTldsRequest) iter.Seq2[*SecurityTlds, error] {
+	return listSeq(ctx, func(ctx context.Context) ([]*SecurityTlds, error) {
+		return s.List(ctx, request)
+	})
+}
+
+// Add adds a TLD to the security TLDs.
+func (s *securityTldsService) Add(ctx context.Context, request *AddSecurityTldsRequest) error {
+	ctx = withOperation(ctx, "SecurityTlds", "Add", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityTldsAPIPath)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, request.SecurityTlds)
+	if err != nil {
+		return fmt.Errorf("error creating request to add to the security TLDs: %w", err)
+	}
+
+	err = ignoreDuplicate(s.client.do(ctx, req, nil), request.IgnoreDuplicates)
+	if err != nil {
+		return fmt.Errorf("error making a request to add to the security TLDs: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a TLD from the security TLDs.
+func (s *securityTldsService) Delete(ctx context.Context, request *DeleteSecurityTldsRequest) error {
+	ctx = withOperation(ctx, "SecurityTlds", "Delete", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), securityTldsIDAPIPath(request.ID))
+	req, err := s.client.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request to delete the security TLDs id %s: %w", request.ID, err)
+	}
+
+	err = ignoreNotFound(s.client.do(ctx, req, nil), request.IgnoreNotFound)
+	if err != nil {
+		return fmt.Errorf("error making a request to delete the security TLDs id %s: %w", request.ID, err)
+	}
+
+	return nil
+}
+
+// securityTldsIDAPIPath returns the HTTP path for the security TLDs API.
+func securityTldsIDAPIPath(id string) string {
+	return itemAPIPath(securityTldsAPIPath, id)
+}
@@ -56,8 +56,9 @@ func NewSettingsService(client *Client) *settingsService {
 
 // Get returns the settings of a profile.
 func (s *settingsService) Get(ctx context.Context, request *GetSettingsRequest) (*Settings, error) {
+	ctx = withOperation(ctx, "Settings", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the settings: %w", err)
 	}
@@ -73,8 +74,9 @@ func (s *settingsService) Get(ctx context.Context, request *GetSettingsRequest)
 
 // Update updates the settings of a profile.
 func (s *settingsService) Update(ctx context.Context, request *UpdateSettingsRequest) error {
+	ctx = withOperation(ctx, "Settings", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.Settings)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.Settings)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the settings: %w", err)
 	}
@@ -53,8 +53,9 @@ func NewSettingsBlockPageService(client *Client) *settingsBlockPageService {
 
 // Get returns the settings block page of a profile.
 func (s *settingsBlockPageService) Get(ctx context.Context, request *GetSettingsBlockPageRequest) (*SettingsBlockPage, error) {
+	ctx = withOperation(ctx, "SettingsBlockPage", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsBlockPageAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the block page settings: %w", err)
 	}
@@ -70,8 +71,9 @@ func (s *settingsBlockPageService) Get(ctx context.Context, request *GetSettings
 
 // Update updates the settings block page of a profile.
 func (s *settingsBlockPageService) Update(ctx context.Context, request *UpdateSettingsBlockPageRequest) error {
+	ctx = withOperation(ctx, "SettingsBlockPage", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsBlockPageAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.SettingsBlockPage)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.SettingsBlockPage)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the block page settings: %w", err)
 	}
@@ -34,10 +34,32 @@ type UpdateSettingsLogsRequest struct {
 	SettingsLogs *SettingsLogs
 }
 
+// SettingsLogsDropPatch represents a partial update of the data dropped from the logs.
+type SettingsLogsDropPatch struct {
+	IP     *bool `json:"ip,omitempty"`
+	Domain *bool `json:"domain,omitempty"`
+}
+
+// SettingsLogsPatch represents a partial update of the settings logs of a profile.
+// Only the fields that are set are sent, the other settings are left unchanged.
+type SettingsLogsPatch struct {
+	Enabled   *bool                  `json:"enabled,omitempty"`
+	Drop      *SettingsLogsDropPatch `json:"drop,omitempty"`
+	Retention *int                   `json:"retention,omitempty"`
+	Location  *string                `json:"location,omitempty"`
+}
+
+// PatchSettingsLogsRequest encapsulates the request for partially updating the settings logs of a profile.
+type PatchSettingsLogsRequest struct {
+	ProfileID    string
+	SettingsLogs *SettingsLogsPatch
+}
+
 // SettingsLogsService is an interface for communicating with the NextDNS settings logs API endpoint.
 type SettingsLogsService interface {
 	Get(context.Context, *GetSettingsLogsRequest) (*SettingsLogs, error)
 	Update(context.Context, *UpdateSettingsLogsRequest) error
+	Patch(context.Context, *PatchSettingsLogsRequest) error
 }
 
 // settingsLogsResponse represents the settings logs response.
@@ -62,8 +84,9 @@ func NewSettingsLogsService(client *Client) *settingsLogsService {
 
 // Get returns the settings logs of a profile.
 func (s *settingsLogsService) Get(ctx context.Context, request *GetSettingsLogsRequest) (*SettingsLogs, error) {
+	ctx = withOperation(ctx, "SettingsLogs", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsLogsAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the logs settings: %w", err)
 	}
@@ -78,9 +101,11 @@ func (s *settingsLogsService) Get(ctx context.Context, request *GetSettingsLogsR
 }
 
 // Update updates the settings logs of a profile.
+// The whole settings are sent, so an unset Enabled disables the logs; use Patch to change only some of them.
 func (s *settingsLogsService) Update(ctx context.Context, request *UpdateSettingsLogsRequest) error {
+	ctx = withOperation(ctx, "SettingsLogs", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsLogsAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.SettingsLogs)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.SettingsLogs)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the logs settings: %w", err)
 	}
@@ -93,3 +118,20 @@ func (s *settingsLogsService) Update(ctx context.Context, request *UpdateSetting
 
 	return nil
 }
+
+// Patch updates the settings logs of a profile that are set in the patch, leaving the other ones unchanged.
+func (s *settingsLogsService) Patch(ctx context.Context, request *PatchSettingsLogsRequest) error {
+	ctx = withOperation(ctx, "SettingsLogs", "Patch", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsLogsAPIPath)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.SettingsLogs)
+	if err != nil {
+		return fmt.Errorf("error creating request to patch the logs settings: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to patch the logs settings: %w", err)
+	}
+
+	return nil
+}
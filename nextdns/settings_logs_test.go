@@ -0,0 +1,35 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestSettingsLogsPatchError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"errors":[{"code":"invalid","source":{"parameter":"retention"}}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	err = client.SettingsLogs.Patch(context.Background(), &PatchSettingsLogsRequest{
+		ProfileID:    "abc123",
+		SettingsLogs: &SettingsLogsPatch{Retention: Int(42)},
+	})
+	c.True(errors.Is(err, ErrValidation))
+
+	var clientErr *Error
+	c.True(errors.As(err, &clientErr))
+	c.Equal(clientErr.APIErrors()[0].Field, "Retention")
+}
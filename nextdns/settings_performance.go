@@ -27,10 +27,25 @@ type UpdateSettingsPerformanceRequest struct {
 	SettingsPerformance *SettingsPerformance
 }
 
+// SettingsPerformancePatch represents a partial update of the settings performance of a profile.
+// Only the fields that are set are sent, the other settings are left unchanged.
+type SettingsPerformancePatch struct {
+	Ecs             *bool `json:"ecs,omitempty"`
+	CacheBoost      *bool `json:"cacheBoost,omitempty"`
+	CnameFlattening *bool `json:"cnameFlattening,omitempty"`
+}
+
+// PatchSettingsPerformanceRequest encapsulates the request for partially updating the settings performance of a profile.
+type PatchSettingsPerformanceRequest struct {
+	ProfileID           string
+	SettingsPerformance *SettingsPerformancePatch
+}
+
 // SettingsPerformanceService is an interface for communicating with the NextDNS settings performance API endpoint.
 type SettingsPerformanceService interface {
 	Get(context.Context, *GetSettingsPerformanceRequest) (*SettingsPerformance, error)
 	Update(context.Context, *UpdateSettingsPerformanceRequest) error
+	Patch(context.Context, *PatchSettingsPerformanceRequest) error
 }
 
 // settingsPerformanceResponse represents the settings performance response.
@@ -55,8 +70,9 @@ func NewSettingsPerformanceService(client *Client) *settingsPerformanceService {
 
 // Get returns the performance settings of a profile.
 func (s *settingsPerformanceService) Get(ctx context.Context, request *GetSettingsPerformanceRequest) (*SettingsPerformance, error) {
+	ctx = withOperation(ctx, "SettingsPerformance", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsPerformanceAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the performance settings: %w", err)
 	}
@@ -71,9 +87,11 @@ func (s *settingsPerformanceService) Get(ctx context.Context, request *GetSettin
 }
 
 // Update updates the performance settings of a profile.
+// Every option is sent, so the ones left false are disabled; use Patch to change only some of them.
 func (s *settingsPerformanceService) Update(ctx context.Context, request *UpdateSettingsPerformanceRequest) error {
+	ctx = withOperation(ctx, "SettingsPerformance", "Update", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsPerformanceAPIPath)
-	req, err := s.client.newRequest(http.MethodPatch, path, request.SettingsPerformance)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.SettingsPerformance)
 	if err != nil {
 		return fmt.Errorf("error creating request to update the performance settings: %w", err)
 	}
@@ -86,3 +104,20 @@ func (s *settingsPerformanceService) Update(ctx context.Context, request *Update
 
 	return nil
 }
+
+// Patch updates the settings performance of a profile that are set in the patch, leaving the other ones unchanged.
+func (s *settingsPerformanceService) Patch(ctx context.Context, request *PatchSettingsPerformanceRequest) error {
+	ctx = withOperation(ctx, "SettingsPerformance", "Patch", request.ProfileID)
+	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), settingsPerformanceAPIPath)
+	req, err := s.client.newRequest(http.MethodPatch, path, nil, request.SettingsPerformance)
+	if err != nil {
+		return fmt.Errorf("error creating request to patch the performance settings: %w", err)
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("error making a request to patch the performance settings: %w", err)
+	}
+
+	return nil
+}
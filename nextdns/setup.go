@@ -49,8 +49,9 @@ func NewSetupService(client *Client) *setupService {
 
 // Get returns the setup settings of a profile.
 func (s *setupService) Get(ctx context.Context, request *GetSetupRequest) (*Setup, error) {
+	ctx = withOperation(ctx, "Setup", "Get", request.ProfileID)
 	path := fmt.Sprintf("%s/%s", profileAPIPath(request.ProfileID), setupAPIPath)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request to get the setup settings: %w", err)
 	}
@@ -0,0 +1,105 @@
+package nextdns
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// streamResult is the result of a call whose response body is streamed to the caller instead of being decoded.
+type streamResult struct {
+	res *http.Response
+}
+
+// stream sends the request like do, but returns the response with its body unread when it succeeds.
+// The errors are handled as usual, and the caller must close the body of the response.
+func (c *Client) stream(ctx context.Context, req *http.Request) (*http.Response, error) {
+	result := &streamResult{}
+	err := c.do(ctx, req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.res, nil
+}
+
+// serverEvent represents an event of a Server-Sent Events stream.
+type serverEvent struct {
+	ID    string
+	Event string
+	Data  string
+
+	// Retry is the reconnection delay asked by the server, if any.
+	Retry time.Duration
+}
+
+// serverEventReader reads the events of a Server-Sent Events stream.
+type serverEventReader struct {
+	scanner *bufio.Scanner
+}
+
+// maxServerEventLine is the maximum size of a line of a Server-Sent Events stream.
+const maxServerEventLine = 1 << 20
+
+// newServerEventReader returns a reader of the events of the stream body.
+func newServerEventReader(res *http.Response) *serverEventReader {
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 0, 4096), maxServerEventLine)
+
+	return &serverEventReader{scanner: scanner}
+}
+
+// next returns the next event of the stream, skipping the comments and the blank events.
+// The events without data are still returned, as they can carry the ID and retry fields.
+// It returns the error of the underlying reader, or io.EOF when the stream ends.
+func (r *serverEventReader) next() (*serverEvent, error) {
+	event := &serverEvent{}
+	data := []string{}
+	blank := true
+
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+
+		// A blank line dispatches the event.
+		if line == "" {
+			if blank {
+				continue
+			}
+
+			event.Data = strings.Join(data, "\n")
+			return event, nil
+		}
+
+		// The lines starting with a colon are comments, used by the servers to keep the connections alive.
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		blank = false
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "id":
+			event.ID = value
+		case "event":
+			event.Event = value
+		case "data":
+			data = append(data, value)
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil {
+				event.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,188 @@
+package nextdns
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// relativeDate matches the relative dates accepted by the API, such as "-7d" or "-6h".
+var relativeDate = regexp.MustCompile(`^-([0-9]+)([smhdwMy])$`)
+
+// unixDate matches the unix timestamps in seconds, with at least 10 digits (i.e. from September 2001) so that
+// dates without separators such as "2024" or "20240101" are not taken for timestamps in 1970.
+var unixDate = regexp.MustCompile(`^[0-9]{10,}$`)
+
+// Date represents a date of a time range, either absolute, relative to now (e.g. "-7d") or now.
+// The zero value is an unset date, letting the API use its default.
+type Date struct {
+	// at is the absolute date.
+	at time.Time
+
+	// ago and unit are the relative date, such as 7 and 'd' for "-7d".
+	ago  int
+	unit byte
+
+	// now is set for the current date.
+	now bool
+}
+
+// At returns the absolute date t.
+func At(t time.Time) Date {
+	return Date{at: t}
+}
+
+// Ago returns the date d before now, expressed in the largest unit accepted by the API that divides it.
+// The duration is truncated to the second, and durations of less than a second return Now.
+func Ago(d time.Duration) Date {
+	d = d.Truncate(time.Second)
+	if d <= 0 {
+		return Now()
+	}
+
+	switch {
+	case d%(24*time.Hour) == 0:
+		return Date{ago: int(d / (24 * time.Hour)), unit: 'd'}
+	case d%time.Hour == 0:
+		return Date{ago: int(d / time.Hour), unit: 'h'}
+	case d%time.Minute == 0:
+		return Date{ago: int(d / time.Minute), unit: 'm'}
+	default:
+		return Date{ago: int(d / time.Second), unit: 's'}
+	}
+}
+
+// Now returns the current date.
+func Now() Date {
+	return Date{now: true}
+}
+
+// ParseDate parses a date in one of the formats accepted by the API: an ISO 8601 date ("2006-01-02") or
+// date-time (RFC 3339), a unix timestamp in seconds of at least 10 digits, a relative date such as "-7d", or "now".
+func ParseDate(s string) (Date, error) {
+	if s == "" {
+		return Date{}, nil
+	}
+	if s == "now" {
+		return Now(), nil
+	}
+
+	if m := relativeDate.FindStringSubmatch(s); m != nil {
+		ago, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Date{}, fmt.Errorf("%w: %q", ErrInvalidTimeRange, s)
+		}
+		return Date{ago: ago, unit: m[2][0]}, nil
+	}
+
+	if unixDate.MatchString(s) {
+		unix, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return Date{}, fmt.Errorf("%w: %q", ErrInvalidTimeRange, s)
+		}
+		return At(time.Unix(unix, 0)), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return At(t), nil
+		}
+	}
+
+	return Date{}, fmt.Errorf("%w: unsupported date %q", ErrInvalidTimeRange, s)
+}
+
+// IsZero reports whether the date is unset.
+func (d Date) IsZero() bool {
+	return d.at.IsZero() && d.unit == 0 && !d.now
+}
+
+// String returns the date as encoded in the query parameters.
+func (d Date) String() string {
+	switch {
+	case d.now:
+		return "now"
+	case d.unit != 0:
+		return fmt.Sprintf("-%d%c", d.ago, d.unit)
+	case !d.at.IsZero():
+		return d.at.UTC().Format(time.RFC3339)
+	default:
+		return ""
+	}
+}
+
+// Time returns the date resolved against now.
+func (d Date) Time(now time.Time) time.Time {
+	switch d.unit {
+	case 's':
+		return now.Add(-time.Duration(d.ago) * time.Second)
+	case 'm':
+		return now.Add(-time.Duration(d.ago) * time.Minute)
+	case 'h':
+		return now.Add(-time.Duration(d.ago) * time.Hour)
+	case 'd':
+		return now.AddDate(0, 0, -d.ago)
+	case 'w':
+		return now.AddDate(0, 0, -7*d.ago)
+	case 'M':
+		return now.AddDate(0, -d.ago, 0)
+	case 'y':
+		return now.AddDate(-d.ago, 0, 0)
+	}
+
+	if d.now {
+		return now
+	}
+
+	return d.at
+}
+
+// TimeRange represents the time range of the analytics and logs requests.
+// Unset dates let the API use its defaults.
+type TimeRange struct {
+	From Date `url:"from,omitempty"`
+	To   Date `url:"to,omitempty"`
+}
+
+// Last returns the time range from d before now until now.
+func Last(d time.Duration) TimeRange {
+	return TimeRange{From: Ago(d)}
+}
+
+// ParseTimeRange parses the from and to dates of a time range, as accepted by ParseDate, and validates it.
+func ParseTimeRange(from string, to string) (TimeRange, error) {
+	var err error
+	r := TimeRange{}
+
+	r.From, err = ParseDate(from)
+	if err != nil {
+		return TimeRange{}, err
+	}
+
+	r.To, err = ParseDate(to)
+	if err != nil {
+		return TimeRange{}, err
+	}
+
+	return r, r.Validate()
+}
+
+// Validate returns an error when the start of the time range is after its end, an unset end being now.
+func (r TimeRange) Validate() error {
+	if r.From.IsZero() {
+		return nil
+	}
+
+	now := time.Now()
+	to := now
+	if !r.To.IsZero() {
+		to = r.To.Time(now)
+	}
+
+	if r.From.Time(now).After(to) {
+		return fmt.Errorf("%w: from %s is after to %s", ErrInvalidTimeRange, r.From, r.To)
+	}
+
+	return nil
+}
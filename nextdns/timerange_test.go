@@ -0,0 +1,91 @@
+package nextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestParseDate(t *testing.T) {
+	c := is.New(t)
+
+	tests := map[string]string{
+		"now":                       "now",
+		"-7d":                       "-7d",
+		"-3M":                       "-3M",
+		"1672531200":                "2023-01-01T00:00:00Z",
+		"2023-01-01":                "2023-01-01T00:00:00Z",
+		"2023-01-01T02:00:00Z":      "2023-01-01T02:00:00Z",
+		"2023-01-01T03:00:00+01:00": "2023-01-01T02:00:00Z",
+	}
+	for input, want := range tests {
+		d, err := ParseDate(input)
+		c.NoErr(err)
+		c.Equal(d.String(), want)
+	}
+
+	for _, input := range []string{"yesterday", "-7x", "7d", "2023-13-01", "2024", "20240101", "-1672531200"} {
+		_, err := ParseDate(input)
+		c.True(errors.Is(err, ErrInvalidTimeRange))
+	}
+}
+
+func TestAgo(t *testing.T) {
+	c := is.New(t)
+
+	c.Equal(Ago(7*24*time.Hour).String(), "-7d")
+	c.Equal(Ago(6*time.Hour).String(), "-6h")
+	c.Equal(Ago(90*time.Minute).String(), "-90m")
+	c.Equal(Ago(1500*time.Millisecond).String(), "-1s")
+	c.Equal(Ago(0).String(), "now")
+}
+
+func TestTimeRangeValidate(t *testing.T) {
+	c := is.New(t)
+
+	_, err := ParseTimeRange("-7d", "now")
+	c.NoErr(err)
+
+	_, err = ParseTimeRange("now", "-1d")
+	c.True(errors.Is(err, ErrInvalidTimeRange))
+
+	r := TimeRange{From: At(time.Now().Add(time.Hour))}
+	c.True(errors.Is(r.Validate(), ErrInvalidTimeRange))
+}
+
+func TestTimeRangeQuery(t *testing.T) {
+	c := is.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		c.Equal(r.URL.Query().Get("from"), "-1d")
+		c.Equal(r.URL.Query().Get("to"), "")
+
+		_, err := w.Write([]byte(`{"data":[]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	client, err := New(WithBaseURL(ts.URL))
+	c.NoErr(err)
+
+	_, err = client.Analytics.GetStatus(context.Background(), &GetAnalyticsStatusRequest{
+		ProfileID:       "abc123",
+		AnalyticsFilter: AnalyticsFilter{TimeRange: Last(24 * time.Hour)},
+	})
+	c.NoErr(err)
+
+	// Invalid time ranges are rejected before sending the request.
+	_, err = client.Analytics.GetStatus(context.Background(), &GetAnalyticsStatusRequest{
+		ProfileID:       "abc123",
+		AnalyticsFilter: AnalyticsFilter{TimeRange: TimeRange{From: Now(), To: Ago(time.Hour)}},
+	})
+	c.True(errors.Is(err, ErrInvalidTimeRange))
+	c.Equal(requests, 1)
+}
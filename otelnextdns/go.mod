@@ -0,0 +1,24 @@
+module github.com/amalucelli/nextdns-go/otelnextdns
+
+go 1.23
+
+require (
+	github.com/amalucelli/nextdns-go v0.0.0
+	github.com/matryer/is v1.4.0
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/sdk v1.28.0
+	go.opentelemetry.io/otel/trace v1.28.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
+	go.opentelemetry.io/otel/metric v1.28.0 // indirect
+	golang.org/x/sys v0.22.0 // indirect
+	golang.org/x/time v0.9.0 // indirect
+)
+
+// The package is developed along with the client, so it is built against the client of the repository.
+replace github.com/amalucelli/nextdns-go => ../
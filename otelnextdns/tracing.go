@@ -0,0 +1,127 @@
+// Package otelnextdns provides the OpenTelemetry tracing of the NextDNS client.
+package otelnextdns
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amalucelli/nextdns-go/nextdns"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of the tracer.
+const tracerName = "github.com/amalucelli/nextdns-go/otelnextdns"
+
+// tracing represents the OpenTelemetry tracing of the client.
+type tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// operationSpanKey is the context key marking that the operation of the context has its own span.
+type operationSpanKey struct{}
+
+// WithTracing enables the OpenTelemetry tracing of the client, creating a span for each service method.
+// The methods making several calls to the NextDNS API (e.g. Rewrites.Upsert) get a span of their own,
+// with a child span for each call. The trace context is propagated to the NextDNS API.
+// The global tracer provider and propagator are used when they are nil.
+func WithTracing(provider trace.TracerProvider, propagator propagation.TextMapPropagator) nextdns.ClientOption {
+	return func(c *nextdns.Client) error {
+		if provider == nil {
+			provider = otel.GetTracerProvider()
+		}
+
+		t := &tracing{
+			tracer:     provider.Tracer(tracerName),
+			propagator: propagator,
+		}
+
+		err := nextdns.WithMiddleware(t.middleware)(c)
+		if err != nil {
+			return err
+		}
+
+		return nextdns.WithOperationHook(t.operation)(c)
+	}
+}
+
+// operation starts the span of a service method making several calls.
+func (t *tracing) operation(ctx context.Context, op *nextdns.Operation) (context.Context, func(err error)) {
+	ctx = context.WithValue(ctx, operationSpanKey{}, true)
+	ctx, span := t.tracer.Start(ctx, "nextdns "+op.Name(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(operationAttributes(op)...),
+	)
+
+	return ctx, func(err error) {
+		endSpan(span, err)
+	}
+}
+
+// middleware starts the span of a call, and injects its trace context into the request.
+func (t *tracing) middleware(next nextdns.Handler) nextdns.Handler {
+	return func(ctx context.Context, call *nextdns.Call) (err error) {
+		req := call.Request
+
+		// The calls of an operation with its own span are named after their HTTP method, as they share the operation.
+		op := call.Operation
+		name := "nextdns " + req.Method
+		if op != nil && op.Service != "" && ctx.Value(operationSpanKey{}) == nil {
+			name = "nextdns " + op.Name()
+		}
+
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", req.URL.Path),
+		}
+		if op != nil && op.Service != "" {
+			attrs = append(attrs, operationAttributes(op)...)
+		}
+
+		ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+		defer func() {
+			if call.StatusCode != 0 {
+				span.SetAttributes(attribute.Int("http.response.status_code", call.StatusCode))
+			}
+			endSpan(span, err)
+		}()
+
+		propagator := t.propagator
+		if propagator == nil {
+			propagator = otel.GetTextMapPropagator()
+		}
+		propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		return next(ctx, call)
+	}
+}
+
+// operationAttributes returns the span attributes of an operation.
+func operationAttributes(op *nextdns.Operation) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("nextdns.operation", op.Name()),
+		attribute.String("nextdns.profile_id", op.ProfileID),
+	}
+}
+
+// endSpan records the error of the call or operation, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		errType := "other"
+
+		var clientErr *nextdns.Error
+		if errors.As(err, &clientErr) {
+			errType = string(clientErr.Type)
+		}
+
+		span.SetAttributes(attribute.String("error.type", errType))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,159 @@
+package otelnextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/amalucelli/nextdns-go/nextdns"
+	"github.com/matryer/is"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestTracing(t *testing.T) {
+	c := is.New(t)
+
+	var traceparent string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		c.Equal(r.Header.Get("X-Api-Key"), "secret")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := nextdns.New(
+		nextdns.WithBaseURL(ts.URL),
+		nextdns.WithAPIKey("secret"),
+		WithTracing(provider, propagation.TraceContext{}),
+	)
+	c.NoErr(err)
+
+	err = client.Denylist.Update(context.Background(), &nextdns.UpdateDenylistRequest{
+		ProfileID: "abc123",
+		ID:        "apple.com",
+		Denylist:  &nextdns.Denylist{Active: true},
+	})
+	c.NoErr(err)
+
+	spans := exporter.GetSpans()
+	c.Equal(len(spans), 1)
+	c.Equal(spans[0].Name, "nextdns Denylist.Update")
+	c.True(traceparent != "")
+	c.True(len(traceparent) > 36)
+	c.Equal(traceparent[3:35], spans[0].SpanContext.TraceID().String())
+
+	attrs := spanAttributes(spans[0])
+	c.Equal(attrs["nextdns.operation"].AsString(), "Denylist.Update")
+	c.Equal(attrs["nextdns.profile_id"].AsString(), "abc123")
+	c.Equal(attrs["http.request.method"].AsString(), http.MethodPatch)
+	c.Equal(attrs["url.path"].AsString(), "/profiles/abc123/denylist/apple.com")
+	c.Equal(attrs["http.response.status_code"].AsInt64(), int64(http.StatusNoContent))
+}
+
+func TestTracingError(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"errors":[{"code":"notFound"}]}`))
+		c.NoErr(err)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := nextdns.New(nextdns.WithBaseURL(ts.URL), WithTracing(provider, nil))
+	c.NoErr(err)
+
+	_, err = client.Profiles.Get(context.Background(), &nextdns.GetProfileRequest{ProfileID: "abc123"})
+	c.True(err != nil)
+
+	spans := exporter.GetSpans()
+	c.Equal(len(spans), 1)
+	c.Equal(spans[0].Name, "nextdns Profiles.Get")
+	c.Equal(spans[0].Status.Code, codes.Error)
+
+	attrs := spanAttributes(spans[0])
+	c.Equal(attrs["error.type"].AsString(), string(nextdns.ErrorTypeNotFound))
+	c.Equal(attrs["http.response.status_code"].AsInt64(), int64(http.StatusNotFound))
+}
+
+func TestTracingOperationSpan(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"data":[{"id":"1","name":"router.local","content":"192.168.1.1"}]}`))
+			c.NoErr(err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := nextdns.New(nextdns.WithBaseURL(ts.URL), WithTracing(provider, nil))
+	c.NoErr(err)
+
+	_, err = client.Rewrites.Upsert(context.Background(), &nextdns.UpsertRewritesRequest{
+		ProfileID: "abc123",
+		Rewrites:  &nextdns.Rewrites{Name: "router.local", Content: "192.168.1.2"},
+	})
+	c.NoErr(err)
+
+	// The calls made by the method are children of its span, and carry its operation.
+	spans := exporter.GetSpans()
+	c.Equal(len(spans), 3)
+	c.Equal(spans[0].Name, "nextdns GET")
+	c.Equal(spans[1].Name, "nextdns PATCH")
+	c.Equal(spans[2].Name, "nextdns Rewrites.Upsert")
+
+	for _, span := range spans[:2] {
+		c.Equal(span.Parent.SpanID(), spans[2].SpanContext.SpanID())
+		c.Equal(spanAttributes(span)["nextdns.operation"].AsString(), "Rewrites.Upsert")
+	}
+}
+
+func TestTracingWithoutOperation(t *testing.T) {
+	c := is.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tr := &tracing{tracer: provider.Tracer(tracerName), propagator: propagation.TraceContext{}}
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.nextdns.io/profiles", nil)
+	c.NoErr(err)
+
+	// A call built by a middleware of its own has no operation.
+	handler := tr.middleware(func(ctx context.Context, call *nextdns.Call) error {
+		return nil
+	})
+	err = handler(context.Background(), &nextdns.Call{Request: req})
+	c.NoErr(err)
+
+	spans := exporter.GetSpans()
+	c.Equal(len(spans), 1)
+	c.Equal(spans[0].Name, "nextdns GET")
+	c.Equal(spanAttributes(spans[0])["nextdns.operation"].Type(), attribute.INVALID)
+}
@@ -0,0 +1,26 @@
+module github.com/amalucelli/nextdns-go/promnextdns
+
+go 1.23
+
+require (
+	github.com/amalucelli/nextdns-go v0.0.0
+	github.com/matryer/is v1.4.0
+	github.com/prometheus/client_golang v1.20.5
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
+	github.com/kylelemons/godebug v1.1.0 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/client_model v0.6.1 // indirect
+	github.com/prometheus/common v0.55.0 // indirect
+	github.com/prometheus/procfs v0.15.1 // indirect
+	golang.org/x/sys v0.22.0 // indirect
+	golang.org/x/time v0.9.0 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+)
+
+// The package is developed along with the client, so it is built against the client of the repository.
+replace github.com/amalucelli/nextdns-go => ../
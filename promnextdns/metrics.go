@@ -0,0 +1,196 @@
+// Package promnextdns provides the Prometheus metrics of the NextDNS client.
+package promnextdns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/amalucelli/nextdns-go/nextdns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "nextdns"
+	metricsSubsystem = "client"
+
+	// statusNone is the status label for the calls that didn't get a response.
+	statusNone = "none"
+
+	// errorTransport is the error type label for the calls that failed before getting a response.
+	errorTransport = "transport"
+
+	// errorOther is the error type label for the other errors, e.g. a response body that can't be decoded.
+	errorOther = "other"
+)
+
+// metricsLabels are the labels of the client metrics.
+var metricsLabels = []string{"endpoint", "method", "status", "error_type"}
+
+// attemptsLabels are the labels of the attempts metric, whose errors are not classified yet.
+var attemptsLabels = []string{"endpoint", "method", "status"}
+
+// metrics represents the Prometheus metrics of the client.
+type metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	attempts *prometheus.CounterVec
+}
+
+// WithMetrics records Prometheus metrics of the calls made by the client, registered in the given registerer,
+// or in the default one when it is nil. The metrics are labelled by the templated endpoint
+// (e.g. "profiles/:id/denylist"), the HTTP method and the HTTP status.
+//
+// The requests_total and request_duration_seconds metrics are recorded once per call, with its final status and
+// the ErrorType of its error, including the errors the NextDNS API returns with a 200 status (e.g. "duplicate").
+// The duration of a call retried by the retry policy includes its retries. The attempts_total metric is recorded
+// for each HTTP request sent for a call, so the attempts retried after a 429 or 5xx status are counted as well.
+func WithMetrics(registerer prometheus.Registerer) nextdns.ClientOption {
+	return func(c *nextdns.Client) error {
+		if registerer == nil {
+			registerer = prometheus.DefaultRegisterer
+		}
+
+		requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Total number of requests made to the NextDNS API.",
+		}, metricsLabels)
+
+		duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the requests made to the NextDNS API.",
+			Buckets:   prometheus.DefBuckets,
+		}, metricsLabels)
+
+		attempts := prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "attempts_total",
+			Help:      "Total number of HTTP requests sent to the NextDNS API, including the retries.",
+		}, attemptsLabels)
+
+		err := registerer.Register(requests)
+		if err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if !errors.As(err, &are) {
+				return err
+			}
+			requests = are.ExistingCollector.(*prometheus.CounterVec)
+		}
+
+		err = registerer.Register(duration)
+		if err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if !errors.As(err, &are) {
+				return err
+			}
+			duration = are.ExistingCollector.(*prometheus.HistogramVec)
+		}
+
+		err = registerer.Register(attempts)
+		if err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if !errors.As(err, &are) {
+				return err
+			}
+			attempts = are.ExistingCollector.(*prometheus.CounterVec)
+		}
+
+		m := &metrics{
+			requests: requests,
+			duration: duration,
+			attempts: attempts,
+		}
+
+		err = nextdns.WithMiddleware(m.middleware)(c)
+		if err != nil {
+			return err
+		}
+
+		return nextdns.WithTransport(m.transport)(c)
+	}
+}
+
+// middleware records the metrics of the calls, once their errors are classified.
+func (m *metrics) middleware(next nextdns.Handler) nextdns.Handler {
+	return func(ctx context.Context, call *nextdns.Call) error {
+		start := time.Now()
+		err := next(ctx, call)
+		elapsed := time.Since(start).Seconds()
+
+		status := statusNone
+		if call.StatusCode != 0 {
+			status = strconv.Itoa(call.StatusCode)
+		}
+
+		labels := prometheus.Labels{
+			"endpoint":   call.Endpoint,
+			"method":     call.Request.Method,
+			"status":     status,
+			"error_type": errorType(call, err),
+		}
+
+		m.requests.With(labels).Inc()
+		m.duration.With(labels).Observe(elapsed)
+
+		return err
+	}
+}
+
+// transport records the attempts of the calls, as the retries are made below the middlewares.
+func (m *metrics) transport(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := next.RoundTrip(req)
+
+		// The redirects to other hosts (e.g. the downloads of the logs) are not attempts of the call.
+		call := nextdns.CallFromContext(req.Context())
+		if call == nil || req.URL.Host != call.Request.URL.Host {
+			return res, err
+		}
+
+		status := statusNone
+		if res != nil {
+			status = strconv.Itoa(res.StatusCode)
+		}
+
+		m.attempts.With(prometheus.Labels{
+			"endpoint": call.Endpoint,
+			"method":   req.Method,
+			"status":   status,
+		}).Inc()
+
+		return res, err
+	})
+}
+
+// roundTripperFunc is an http.RoundTripper implemented by a function.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls the function with the request.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// errorType returns the error type label of a call.
+func errorType(call *nextdns.Call, err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var clientErr *nextdns.Error
+	if errors.As(err, &clientErr) {
+		return string(clientErr.Type)
+	}
+
+	if call.StatusCode == 0 {
+		return errorTransport
+	}
+
+	return errorOther
+}
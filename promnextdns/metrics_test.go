@@ -0,0 +1,118 @@
+package promnextdns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amalucelli/nextdns-go/nextdns"
+	"github.com/matryer/is"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	c := is.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"errors":[{"code":"notFound"}]}`))
+			c.NoErr(err)
+		case http.MethodPost:
+			// The duplicates are reported with a 200 error envelope.
+			_, err := w.Write([]byte(`{"errors":[{"code":"duplicate","source":{"parameter":"id"}}]}`))
+			c.NoErr(err)
+		default:
+			_, err := w.Write([]byte(`{"data":[]}`))
+			c.NoErr(err)
+		}
+	}))
+	defer ts.Close()
+
+	registry := prometheus.NewRegistry()
+	client, err := nextdns.New(nextdns.WithBaseURL(ts.URL), nextdns.WithAPIKey("secret"), WithMetrics(registry))
+	c.NoErr(err)
+
+	ctx := context.Background()
+	for _, profile := range []string{"abc123", "def456"} {
+		_, err = client.Denylist.List(ctx, &nextdns.ListDenylistRequest{ProfileID: profile})
+		c.NoErr(err)
+	}
+
+	err = client.Denylist.Update(ctx, &nextdns.UpdateDenylistRequest{
+		ProfileID: "abc123",
+		ID:        "apple.com",
+		Denylist:  &nextdns.Denylist{Active: true},
+	})
+	c.True(err != nil)
+
+	err = client.Denylist.Add(ctx, &nextdns.AddDenylistRequest{
+		ProfileID: "abc123",
+		Denylist:  &nextdns.Denylist{ID: "apple.com", Active: true},
+	})
+	c.True(err != nil)
+
+	expected := `
+# HELP nextdns_client_requests_total Total number of requests made to the NextDNS API.
+# TYPE nextdns_client_requests_total counter
+nextdns_client_requests_total{endpoint="profiles/:id/denylist",error_type="",method="GET",status="200"} 2
+nextdns_client_requests_total{endpoint="profiles/:id/denylist",error_type="duplicate",method="POST",status="200"} 1
+nextdns_client_requests_total{endpoint="profiles/:id/denylist/:item",error_type="not_found",method="PATCH",status="404"} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected), "nextdns_client_requests_total")
+	c.NoErr(err)
+	c.Equal(testutil.CollectAndCount(registry, "nextdns_client_request_duration_seconds"), 3)
+
+	// A second client can share the same registry.
+	_, err = nextdns.New(nextdns.WithBaseURL(ts.URL), WithMetrics(registry))
+	c.NoErr(err)
+}
+
+func TestMetricsAttempts(t *testing.T) {
+	c := is.New(t)
+
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_, err := w.Write([]byte(`{"data":[]}`))
+			c.NoErr(err)
+		}
+	}))
+	defer ts.Close()
+
+	registry := prometheus.NewRegistry()
+	client, err := nextdns.New(
+		nextdns.WithBaseURL(ts.URL),
+		nextdns.WithRetryPolicy(&nextdns.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}),
+		WithMetrics(registry),
+	)
+	c.NoErr(err)
+
+	_, err = client.Denylist.List(context.Background(), &nextdns.ListDenylistRequest{ProfileID: "abc123"})
+	c.NoErr(err)
+
+	// The call is recorded once, and each of its attempts is counted.
+	expected := `
+# HELP nextdns_client_attempts_total Total number of HTTP requests sent to the NextDNS API, including the retries.
+# TYPE nextdns_client_attempts_total counter
+nextdns_client_attempts_total{endpoint="profiles/:id/denylist",method="GET",status="200"} 1
+nextdns_client_attempts_total{endpoint="profiles/:id/denylist",method="GET",status="429"} 1
+nextdns_client_attempts_total{endpoint="profiles/:id/denylist",method="GET",status="503"} 1
+# HELP nextdns_client_requests_total Total number of requests made to the NextDNS API.
+# TYPE nextdns_client_requests_total counter
+nextdns_client_requests_total{endpoint="profiles/:id/denylist",error_type="",method="GET",status="200"} 1
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected), "nextdns_client_attempts_total", "nextdns_client_requests_total")
+	c.NoErr(err)
+}